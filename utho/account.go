@@ -1,9 +1,5 @@
 package utho
 
-import (
-	"errors"
-)
-
 type AccountService service
 
 type Account struct {
@@ -69,19 +65,82 @@ type Resources struct {
 
 func (s *AccountService) Read() (*User, error) {
 	userUrl := "account/info"
-	req, _ := s.client.NewRequest("GET", userUrl)
+	req, err := s.client.NewRequest("GET", userUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var account Account
-	_, err := s.client.Do(req, &account)
+	resp, err := s.client.Do(req, &account)
 	if err != nil {
 		return nil, err
 	}
 	if account.Status != "success" && account.Status != "" {
-		return nil, errors.New(account.Message)
+		return nil, newErrorResponse(resp, account.Message)
 	}
 	if len(account.User.ID) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("account not found")
 	}
 
 	return &account.User, nil
 }
+
+type Balance struct {
+	Credit          float64 `json:"credit"`
+	Availablecredit float64 `json:"availablecredit"`
+	Freecredit      float64 `json:"freecredit"`
+	Currentusages   float64 `json:"currentusages"`
+	Currency        string  `json:"currency"`
+	Currencyprefix  string  `json:"currencyprefix"`
+}
+
+// GetBalance returns the credit of the account, in the account currency
+func (s *AccountService) GetBalance() (*Balance, error) {
+	user, err := s.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Balance{
+		Credit:          user.Credit,
+		Availablecredit: user.Availablecredit,
+		Freecredit:      user.Freecredit,
+		Currentusages:   user.Currentusages,
+		Currency:        user.Currency,
+		Currencyprefix:  user.Currencyprefix,
+	}, nil
+}
+
+type Datacenters struct {
+	Datacenters []Datacenter `json:"datacenters"`
+	Status      string       `json:"status,omitempty"`
+	Message     string       `json:"message,omitempty"`
+}
+type Datacenter struct {
+	// Slug is the value expected by the dcslug field of create requests
+	Slug     string `json:"slug"`
+	Location string `json:"location"`
+	Country  string `json:"country"`
+	Dccc     string `json:"dccc"`
+	Status   string `json:"status"`
+}
+
+// ListDatacenters returns the datacenters resources can be deployed in
+func (s *AccountService) ListDatacenters() ([]Datacenter, error) {
+	reqUrl := "cloud/datacenters"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var datacenters Datacenters
+	resp, err := s.client.Do(req, &datacenters)
+	if err != nil {
+		return nil, err
+	}
+	if datacenters.Status != "success" && datacenters.Status != "" {
+		return nil, newErrorResponse(resp, datacenters.Message)
+	}
+
+	return datacenters.Datacenters, nil
+}
@@ -42,6 +42,87 @@ func TestAccountService_Read_invalidServer(t *testing.T) {
 	}
 }
 
+func TestAccountService_GetBalance_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/account/info", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"status":"success","user":{"id":"1","currency":"inr","currencyprefix":"₹","credit":120.5,"availablecredit":100,"freecredit":20.5,"currentusages":12.25}}`)
+	})
+
+	want := Balance{
+		Credit:          120.5,
+		Availablecredit: 100,
+		Freecredit:      20.5,
+		Currentusages:   12.25,
+		Currency:        "inr",
+		Currencyprefix:  "₹",
+	}
+
+	got, err := client.Account().GetBalance()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("Response = %v, want %v", *got, want)
+	}
+
+	encoded, _ := json.Marshal(got)
+	wantJson := `{"credit":120.5,"availablecredit":100,"freecredit":20.5,"currentusages":12.25,"currency":"inr","currencyprefix":"₹"}`
+	if string(encoded) != wantJson {
+		t.Errorf("Encoded = %s, want %s", encoded, wantJson)
+	}
+}
+
+func TestAccountService_GetBalance_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	balance, err := client.Account().GetBalance()
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if balance != nil {
+		t.Errorf("Was not expecting any balance to be returned, instead got %v", balance)
+	}
+}
+
+func TestAccountService_ListDatacenters_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/datacenters", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"datacenters":[
+			{"slug":"inmumbaizone2","location":"Mumbai","country":"India","dccc":"in","status":"active"}
+		],"status":"success"}`)
+	})
+
+	want := []Datacenter{{Slug: "inmumbaizone2", Location: "Mumbai", Country: "India", Dccc: "in", Status: "active"}}
+
+	got, err := client.Account().ListDatacenters()
+	if err != nil {
+		t.Errorf("Was not expecting an error, got %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, want %v", got, want)
+	}
+}
+
+func TestAccountService_ListDatacenters_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	datacenters, err := client.Account().ListDatacenters()
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if datacenters != nil {
+		t.Errorf("Was not expecting any datacenters to be returned, instead got %v", datacenters)
+	}
+}
+
 const dummyReadAccountServerRes = `{
     "user": {
         "id": "32154",
@@ -1,7 +1,11 @@
 package utho
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 )
 
 type ActionService service
@@ -25,16 +29,158 @@ type Action struct {
 
 func (s *ActionService) List() ([]Action, error) {
 	actionUrl := "actions"
-	req, _ := s.client.NewRequest("GET", actionUrl)
+	req, err := s.client.NewRequest("GET", actionUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var actions Actions
-	_, err := s.client.Do(req, &actions)
+	resp, err := s.client.Do(req, &actions)
 	if err != nil {
 		return nil, err
 	}
 	if actions.Status != "success" && actions.Status != "" {
-		return nil, errors.New(actions.Message)
+		return nil, newErrorResponse(resp, actions.Message)
 	}
 
 	return actions.Actions, nil
 }
+
+func (s *ActionService) Read(actionId string) (*Action, error) {
+	actionUrl := "actions/" + actionId
+	req, err := s.client.NewRequest("GET", actionUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions Actions
+	resp, err := s.client.Do(req, &actions)
+	if err != nil {
+		return nil, err
+	}
+	if actions.Status != "success" && actions.Status != "" {
+		return nil, newErrorResponse(resp, actions.Message)
+	}
+	if len(actions.Actions) == 0 {
+		return nil, newNotFoundError("action %s not found", actionId)
+	}
+
+	return &actions.Actions[0], nil
+}
+
+// ActionStatus is the state of an asynchronous action
+type ActionStatus string
+
+const (
+	ActionStatusPending   ActionStatus = "pending"
+	ActionStatusCompleted ActionStatus = "completed"
+	ActionStatusErrored   ActionStatus = "errored"
+)
+
+// State maps the status reported by the API onto an ActionStatus
+func (a Action) State() ActionStatus {
+	switch strings.ToLower(a.Status) {
+	case "success", "completed", "complete":
+		return ActionStatusCompleted
+	case "failed", "error", "errored":
+		return ActionStatusErrored
+	default:
+		return ActionStatusPending
+	}
+}
+
+const defaultPollInterval = 5 * time.Second
+
+// WaitOption tunes how the WaitFor helpers poll
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	interval time.Duration
+	timeout  time.Duration
+}
+
+func newWaitOptions(opts []WaitOption) (waitOptions, error) {
+	o := waitOptions{interval: defaultPollInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.interval <= 0 {
+		return o, errors.New("interval must be positive")
+	}
+
+	return o, nil
+}
+
+// WithPollInterval sets the delay between two polls, 5 seconds by default
+func WithPollInterval(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.interval = d
+	}
+}
+
+// WithWaitTimeout gives up waiting after d, on top of any deadline set on the context
+func WithWaitTimeout(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.timeout = d
+	}
+}
+
+// context bounds ctx by the wait timeout, if one is set
+func (o waitOptions) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout > 0 {
+		return context.WithTimeout(ctx, o.timeout)
+	}
+
+	return ctx, func() {}
+}
+
+// poll calls check every interval until it reports done or fails, or until ctx is done.
+// what names the awaited resource in the error returned when ctx is done.
+func (o waitOptions) poll(ctx context.Context, what string, check func() (bool, error)) error {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %s: %w", what, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForAction polls an action until it completes or errors, or until ctx is done.
+// An action that errors is returned along with an error.
+func (s *ActionService) WaitForAction(ctx context.Context, actionId string, opts ...WaitOption) (*Action, error) {
+	o, err := newWaitOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	var action *Action
+	err = o.poll(ctx, "action "+actionId, func() (bool, error) {
+		var err error
+		if action, err = s.Read(actionId); err != nil {
+			return false, err
+		}
+		switch action.State() {
+		case ActionStatusCompleted:
+			return true, nil
+		case ActionStatusErrored:
+			return true, fmt.Errorf("action %s (%s) failed", actionId, action.Action)
+		}
+		return false, nil
+	})
+	if err != nil && (action == nil || action.State() != ActionStatusErrored) {
+		return nil, err
+	}
+
+	return action, err
+}
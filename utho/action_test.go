@@ -1,11 +1,15 @@
 package utho
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestActionService_List_happyPath(t *testing.T) {
@@ -34,6 +38,91 @@ func TestActionService_List_happyPath(t *testing.T) {
 	}
 }
 
+func TestActionService_Read_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/actions/124214", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyActionServerRes)
+	})
+
+	var want Action
+	_ = json.Unmarshal([]byte(dummyReadActionRes), &want)
+
+	got, err := client.Action().Read("124214")
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestActionService_Read_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	action, err := client.Action().Read("124214")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if action != nil {
+		t.Errorf("Was not expecting any action to be returned, instead got %v", action)
+	}
+}
+
+func TestAction_State(t *testing.T) {
+	assert.Equal(t, ActionStatusCompleted, Action{Status: "Success"}.State())
+	assert.Equal(t, ActionStatusErrored, Action{Status: "Failed"}.State())
+	assert.Equal(t, ActionStatusPending, Action{Status: "Pending"}.State())
+}
+
+func TestActionService_WaitForAction_completes(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/actions/124214", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		status := "Pending"
+		if polls == 3 {
+			status = "Success"
+		}
+		fmt.Fprintf(w, `{"actions":[{"id":"124214","action":"start","status":%q}]}`, status)
+	})
+
+	got, err := client.Action().WaitForAction(context.Background(), "124214", WithPollInterval(time.Millisecond))
+	assert.Nil(t, err)
+	assert.Equal(t, ActionStatusCompleted, got.State())
+	assert.Equal(t, 3, polls)
+}
+
+func TestActionService_WaitForAction_errored(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/actions/124214", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"actions":[{"id":"124214","action":"rebuild","status":"Failed"}]}`)
+	})
+
+	got, err := client.Action().WaitForAction(context.Background(), "124214", WithPollInterval(time.Millisecond))
+	assert.ErrorContains(t, err, "action 124214 (rebuild) failed")
+	assert.Equal(t, ActionStatusErrored, got.State())
+}
+
+func TestActionService_WaitForAction_contextDone(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/actions/124214", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"actions":[{"id":"124214","status":"Pending"}]}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	got, err := client.Action().WaitForAction(ctx, "124214", WithPollInterval(5*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, got)
+}
+
 const dummyReadActionRes = `{
 	"userid": "11111",
 	"id": "124214",
@@ -1,7 +1,7 @@
 package utho
 
 import (
-	"errors"
+	"time"
 )
 
 type ApiKeyService service
@@ -12,10 +12,15 @@ type ApiKeys struct {
 	API     []ApiKey `json:"api"`
 }
 type ApiKey struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Write     string `json:"write"`
-	CreatedAt string `json:"created_at"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Write     FlexBool `json:"write"`
+	CreatedAt string   `json:"created_at"`
+}
+
+// Created returns the time the key was created at
+func (a ApiKey) Created() (time.Time, error) {
+	return parseTimestamp(a.CreatedAt)
 }
 
 type CreateApiKeyParams struct {
@@ -23,6 +28,11 @@ type CreateApiKeyParams struct {
 	Write string `json:"write"`
 }
 
+// Validate checks that the fields the API requires to generate a key are set
+func (p CreateApiKeyParams) Validate() error {
+	return checkRequired(requiredField{"name", p.Name})
+}
+
 type CreateApiKeyResponse struct {
 	Status  string `json:"status"`
 	Apikey  string `json:"apikey"`
@@ -30,31 +40,41 @@ type CreateApiKeyResponse struct {
 }
 
 func (s *ApiKeyService) Create(params CreateApiKeyParams) (*CreateApiKeyResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "api/generate"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var apiKey CreateApiKeyResponse
-	_, err := s.client.Do(req, &apiKey)
+	resp, err := s.client.Do(req, &apiKey)
 	if err != nil {
 		return nil, err
 	}
 	if apiKey.Status != "success" && apiKey.Status != "" {
-		return nil, errors.New(apiKey.Message)
+		return nil, newErrorResponse(resp, apiKey.Message)
 	}
 	return &apiKey, nil
 }
 
-func (s *ApiKeyService) List() ([]ApiKey, error) {
-	reqUrl := "api"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+func (s *ApiKeyService) List(opts ...ListOptions) ([]ApiKey, error) {
+	reqUrl := addListOptions("api", opts)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var apikeys ApiKeys
-	_, err := s.client.Do(req, &apikeys)
+	resp, err := s.client.Do(req, &apikeys)
 	if err != nil {
 		return nil, err
 	}
 	if apikeys.Status != "success" && apikeys.Status != "" {
-		return nil, errors.New(apikeys.Message)
+		return nil, newErrorResponse(resp, apikeys.Message)
 	}
 
 	return apikeys.API, nil
@@ -62,14 +82,18 @@ func (s *ApiKeyService) List() ([]ApiKey, error) {
 
 func (s *ApiKeyService) Delete(apiKeyId string) (*DeleteResponse, error) {
 	reqUrl := "api/" + apiKeyId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -69,6 +70,24 @@ func TestApiKeyService_List_happyPath(t *testing.T) {
 	}
 }
 
+func TestApiKeyService_List_listOptions(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/api", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		if got := req.URL.RawQuery; got != "page=3&per_page=20" {
+			t.Errorf("Query = %v, want %v", got, "page=3&per_page=20")
+		}
+		fmt.Fprint(w, dummyListApiKeyServerRes)
+	})
+
+	_, err := client.ApiKey().List(ListOptions{Page: 3, PerPage: 20})
+	if err != nil {
+		t.Errorf("Was not expecting an error, instead got %v", err)
+	}
+}
+
 func TestApiKeyService_List_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
@@ -114,6 +133,14 @@ func TestApiKeyService_Delete_invalidServer(t *testing.T) {
 	}
 }
 
+func TestApiKeyService_Create_invalidParams(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.ApiKey().Create(CreateApiKeyParams{Write: "on"})
+	assert.EqualError(t, err, "missing required fields: name")
+	assert.Nil(t, got)
+}
+
 const dummyCreateApiKeyRequestJson = `{
     "name": "example-name",
 	"write": "on"
@@ -157,3 +184,28 @@ const dummyListApiKeyServerRes = `{
 		}
 	]
 }`
+
+func TestApiKey_Created(t *testing.T) {
+	tests := []struct {
+		createdAt string
+		want      time.Time
+		wantErr   bool
+	}{
+		{createdAt: "2024-05-01 10:30:00", want: time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{createdAt: "2024-05-01T10:30:00Z", want: time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{createdAt: "2024-05-01", want: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{createdAt: ""},
+		{createdAt: "0000-00-00 00:00:00"},
+		{createdAt: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ApiKey{CreatedAt: tt.createdAt}.Created()
+		if tt.wantErr {
+			assert.NotNil(t, err, tt.createdAt)
+			continue
+		}
+		assert.Nil(t, err, tt.createdAt)
+		assert.Equal(t, tt.want, got, tt.createdAt)
+	}
+}
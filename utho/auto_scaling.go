@@ -1,7 +1,9 @@
 package utho
 
 import (
-	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -171,17 +173,55 @@ type CreateAutoScalingResponse struct {
 	Message string `json:"message"`
 }
 
+// validateAutoScalingSizes checks that minsize <= desiredsize <= maxsize, ignoring sizes left empty
+func validateAutoScalingSizes(minsize, maxsize, desiredsize string) error {
+	sizes := map[string]string{"minsize": minsize, "maxsize": maxsize, "desiredsize": desiredsize}
+	parsed := map[string]int{}
+	for name, size := range sizes {
+		if size == "" {
+			continue
+		}
+		n, err := strconv.Atoi(size)
+		if err != nil {
+			return fmt.Errorf("%s must be a number, got %q", name, size)
+		}
+		parsed[name] = n
+	}
+
+	minN, hasMin := parsed["minsize"]
+	maxN, hasMax := parsed["maxsize"]
+	desiredN, hasDesired := parsed["desiredsize"]
+	if hasMin && hasMax && minN > maxN {
+		return fmt.Errorf("minsize %d must not be greater than maxsize %d", minN, maxN)
+	}
+	if hasDesired && hasMin && desiredN < minN {
+		return fmt.Errorf("desiredsize %d must not be less than minsize %d", desiredN, minN)
+	}
+	if hasDesired && hasMax && desiredN > maxN {
+		return fmt.Errorf("desiredsize %d must not be greater than maxsize %d", desiredN, maxN)
+	}
+
+	return nil
+}
+
 func (s *AutoScalingService) Create(params CreateAutoScalingParams) (*CreateAutoScalingResponse, error) {
+	if err := validateAutoScalingSizes(params.Minsize, params.Maxsize, params.Desiredsize); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "autoscaling"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateAutoScalingResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -189,18 +229,21 @@ func (s *AutoScalingService) Create(params CreateAutoScalingParams) (*CreateAuto
 
 func (s *AutoScalingService) Read(autoscalingId string) (*Groups, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	if len(autoscalings.Groups) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("auto scaling group %s not found", autoscalingId)
 	}
 
 	return &autoscalings.Groups[0], nil
@@ -208,22 +251,25 @@ func (s *AutoScalingService) Read(autoscalingId string) (*Groups, error) {
 
 func (s *AutoScalingService) List() ([]Groups, error) {
 	reqUrl := "autoscaling"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups, nil
 }
 
 type UpdateAutoScalingParams struct {
-	AutoScalingId string
+	AutoScalingId string `json:"-"`
 	Name          string `json:"name"`
 	Minsize       string `json:"minsize"`
 	Maxsize       string `json:"maxsize"`
@@ -231,36 +277,58 @@ type UpdateAutoScalingParams struct {
 }
 
 func (s *AutoScalingService) Update(params UpdateAutoScalingParams) (*UpdateResponse, error) {
+	if err := validateAutoScalingSizes(params.Minsize, params.Maxsize, params.Desiredsize); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "autoscaling/" + params.AutoScalingId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling UpdateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
 }
 
 func (s *AutoScalingService) Delete(autoscalingId, autoscalingName string) (*DeleteResponse, error) {
-	reqUrl := "autoscaling/" + autoscalingId + "?name=" + autoscalingName
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	reqUrl := "autoscaling/" + autoscalingId + "?name=" + url.QueryEscape(autoscalingName)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
+// ListInstances returns the instances currently managed by an auto scaling group.
+// Instances.Status holds the lifecycle state of each instance, e.g. "Active" or "Pending".
+func (s *AutoScalingService) ListInstances(autoscalingId string) ([]Instances, error) {
+	group, err := s.Read(autoscalingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.Instances, nil
+}
+
 // Auto Scaling Policy
 type CreateAutoScalingPolicyParams struct {
 	Name      string `json:"name"`
@@ -276,15 +344,18 @@ type CreateAutoScalingPolicyParams struct {
 
 func (s *AutoScalingService) CreatePolicy(params CreateAutoScalingPolicyParams) (*CreateResponse, error) {
 	reqUrl := "autoscaling/policy"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -292,15 +363,18 @@ func (s *AutoScalingService) CreatePolicy(params CreateAutoScalingPolicyParams)
 
 func (s *AutoScalingService) ReadPolicy(autoscalingId, policyId string) (*Policy, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	var policies Policy
 	for _, r := range autoscalings.Groups[0].Policies {
@@ -309,7 +383,7 @@ func (s *AutoScalingService) ReadPolicy(autoscalingId, policyId string) (*Policy
 		}
 	}
 	if len(policies.ID) == 0 {
-		return nil, errors.New("auto scaling policy not found")
+		return nil, newNotFoundError("auto scaling policy not found")
 	}
 
 	return &policies, nil
@@ -317,15 +391,18 @@ func (s *AutoScalingService) ReadPolicy(autoscalingId, policyId string) (*Policy
 
 func (s *AutoScalingService) ListPolicies(autoscalingId string) ([]Policy, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups[0].Policies, nil
@@ -344,15 +421,18 @@ type UpdateAutoScalingPolicyParams struct {
 
 func (s *AutoScalingService) UpdatePolicy(params UpdateAutoScalingPolicyParams) (*UpdateResponse, error) {
 	reqUrl := "autoscaling/policy/" + params.AutoScalingPolicyId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling UpdateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -360,14 +440,18 @@ func (s *AutoScalingService) UpdatePolicy(params UpdateAutoScalingPolicyParams)
 
 func (s *AutoScalingService) DeletePolicy(autoScalingPolicyId string) (*DeleteResponse, error) {
 	reqUrl := "autoscaling/policy/" + autoScalingPolicyId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -375,7 +459,7 @@ func (s *AutoScalingService) DeletePolicy(autoScalingPolicyId string) (*DeleteRe
 
 // Auto Scaling Schedule
 type CreateAutoScalingScheduleParams struct {
-	AutoScalingId string
+	AutoScalingId string `json:"-"`
 	Name          string `json:"name"`
 	Desiredsize   string `json:"desiredsize"`
 	Recurrence    string `json:"recurrence"`
@@ -384,15 +468,18 @@ type CreateAutoScalingScheduleParams struct {
 
 func (s *AutoScalingService) CreateSchedule(params CreateAutoScalingScheduleParams) (*CreateResponse, error) {
 	reqUrl := "autoscaling/" + params.AutoScalingId + "/schedulepolicy"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -400,15 +487,18 @@ func (s *AutoScalingService) CreateSchedule(params CreateAutoScalingSchedulePara
 
 func (s *AutoScalingService) ReadSchedule(autoscalingId, scheduleId string) (*Schedule, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	var schedules Schedule
 	for _, r := range autoscalings.Groups[0].Schedules {
@@ -417,7 +507,7 @@ func (s *AutoScalingService) ReadSchedule(autoscalingId, scheduleId string) (*Sc
 		}
 	}
 	if len(schedules.ID) == 0 {
-		return nil, errors.New("auto scaling schedule not found")
+		return nil, newNotFoundError("auto scaling schedule not found")
 	}
 
 	return &schedules, nil
@@ -425,23 +515,26 @@ func (s *AutoScalingService) ReadSchedule(autoscalingId, scheduleId string) (*Sc
 
 func (s *AutoScalingService) ListSchedules(autoscalingId string) ([]Schedule, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups[0].Schedules, nil
 }
 
 type UpdateAutoScalingScheduleParams struct {
-	AutoScalingeId        string
-	AutoScalingScheduleId string
+	AutoScalingeId        string `json:"-"`
+	AutoScalingScheduleId string `json:"-"`
 	Name                  string `json:"name"`
 	Desiredsize           string `json:"desiredsize"`
 	Recurrence            string `json:"recurrence"`
@@ -450,15 +543,18 @@ type UpdateAutoScalingScheduleParams struct {
 
 func (s *AutoScalingService) UpdateSchedule(params UpdateAutoScalingScheduleParams) (*UpdateResponse, error) {
 	reqUrl := "autoscaling/" + params.AutoScalingeId + "/schedulepolicy/" + params.AutoScalingScheduleId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling UpdateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -466,14 +562,18 @@ func (s *AutoScalingService) UpdateSchedule(params UpdateAutoScalingSchedulePara
 
 func (s *AutoScalingService) DeleteSchedule(autoScalingeId, autoScalingScheduleId string) (*DeleteResponse, error) {
 	reqUrl := "autoscaling/" + autoScalingeId + "/schedulepolicy/" + autoScalingScheduleId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -487,15 +587,18 @@ type CreateAutoScalingLoadbalancerParams struct {
 
 func (s *AutoScalingService) CreateLoadbalancer(params CreateAutoScalingLoadbalancerParams) (*CreateResponse, error) {
 	reqUrl := "autoscaling/" + params.AutoScalingId + "/loadbalancer/" + params.LoadbalancerId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -503,15 +606,18 @@ func (s *AutoScalingService) CreateLoadbalancer(params CreateAutoScalingLoadbala
 
 func (s *AutoScalingService) ReadLoadbalancer(autoscalingId, loadbalancerId string) (*AutoScalingLoadbalancers, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	var loadbalancers AutoScalingLoadbalancers
 	for _, r := range autoscalings.Groups[0].Loadbalancers {
@@ -520,7 +626,7 @@ func (s *AutoScalingService) ReadLoadbalancer(autoscalingId, loadbalancerId stri
 		}
 	}
 	if len(loadbalancers.ID) == 0 {
-		return nil, errors.New("auto scaling loadbalancer not found")
+		return nil, newNotFoundError("auto scaling loadbalancer not found")
 	}
 
 	return &loadbalancers, nil
@@ -528,15 +634,18 @@ func (s *AutoScalingService) ReadLoadbalancer(autoscalingId, loadbalancerId stri
 
 func (s *AutoScalingService) ListLoadbalancers(autoscalingId string) ([]AutoScalingLoadbalancers, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups[0].Loadbalancers, nil
@@ -544,14 +653,18 @@ func (s *AutoScalingService) ListLoadbalancers(autoscalingId string) ([]AutoScal
 
 func (s *AutoScalingService) DeleteLoadbalancer(autoScalingeId, autoScalingLoadbalancerId string) (*DeleteResponse, error) {
 	reqUrl := "autoscaling/" + autoScalingeId + "/loadbalancerpolicy/" + autoScalingLoadbalancerId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -565,15 +678,18 @@ type CreateAutoScalingSecurityGroupParams struct {
 
 func (s *AutoScalingService) CreateSecurityGroup(params CreateAutoScalingSecurityGroupParams) (*CreateResponse, error) {
 	reqUrl := "autoscaling/" + params.AutoScalingId + "/securitygroup/" + params.AutoScalingSecurityGroupId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -581,15 +697,18 @@ func (s *AutoScalingService) CreateSecurityGroup(params CreateAutoScalingSecurit
 
 func (s *AutoScalingService) ReadSecurityGroup(autoscalingId, securitygroupId string) (*SecurityGroup, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	var securitygroups SecurityGroup
 	for _, r := range autoscalings.Groups[0].SecurityGroups {
@@ -598,7 +717,7 @@ func (s *AutoScalingService) ReadSecurityGroup(autoscalingId, securitygroupId st
 		}
 	}
 	if len(securitygroups.ID) == 0 {
-		return nil, errors.New("auto scaling securitygroup not found")
+		return nil, newNotFoundError("auto scaling securitygroup not found")
 	}
 
 	return &securitygroups, nil
@@ -606,15 +725,18 @@ func (s *AutoScalingService) ReadSecurityGroup(autoscalingId, securitygroupId st
 
 func (s *AutoScalingService) ListSecurityGroups(autoscalingId string) ([]SecurityGroup, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups[0].SecurityGroups, nil
@@ -622,14 +744,18 @@ func (s *AutoScalingService) ListSecurityGroups(autoscalingId string) ([]Securit
 
 func (s *AutoScalingService) DeleteSecurityGroup(autoScalingeId, autoScalingSecurityGroupId string) (*DeleteResponse, error) {
 	reqUrl := "autoscaling/" + autoScalingeId + "/securitygroup/" + autoScalingSecurityGroupId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -643,15 +769,18 @@ type CreateAutoScalingTargetgroupParams struct {
 
 func (s *AutoScalingService) CreateTargetgroup(params CreateAutoScalingTargetgroupParams) (*CreateResponse, error) {
 	reqUrl := "autoscaling/" + params.AutoScalingId + "/targetgroup/" + params.AutoScalingTargetgroupId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscaling CreateResponse
-	_, err := s.client.Do(req, &autoscaling)
+	resp, err := s.client.Do(req, &autoscaling)
 	if err != nil {
 		return nil, err
 	}
 	if autoscaling.Status != "success" && autoscaling.Status != "" {
-		return nil, errors.New(autoscaling.Message)
+		return nil, newErrorResponse(resp, autoscaling.Message)
 	}
 
 	return &autoscaling, nil
@@ -659,15 +788,18 @@ func (s *AutoScalingService) CreateTargetgroup(params CreateAutoScalingTargetgro
 
 func (s *AutoScalingService) ReadTargetgroup(autoscalingId, targetgroupId string) (*AutoScalingTargetGroup, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 	var targetgroups AutoScalingTargetGroup
 	for _, r := range autoscalings.Groups[0].TargetGroups {
@@ -676,7 +808,7 @@ func (s *AutoScalingService) ReadTargetgroup(autoscalingId, targetgroupId string
 		}
 	}
 	if len(targetgroups.ID) == 0 {
-		return nil, errors.New("auto scaling targetgroup not found")
+		return nil, newNotFoundError("auto scaling targetgroup not found")
 	}
 
 	return &targetgroups, nil
@@ -684,15 +816,18 @@ func (s *AutoScalingService) ReadTargetgroup(autoscalingId, targetgroupId string
 
 func (s *AutoScalingService) ListTargetgroups(autoscalingId string) ([]AutoScalingTargetGroup, error) {
 	reqUrl := "autoscaling/" + autoscalingId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var autoscalings AutoScalings
-	_, err := s.client.Do(req, &autoscalings)
+	resp, err := s.client.Do(req, &autoscalings)
 	if err != nil {
 		return nil, err
 	}
 	if autoscalings.Status != "success" && autoscalings.Status != "" {
-		return nil, errors.New(autoscalings.Message)
+		return nil, newErrorResponse(resp, autoscalings.Message)
 	}
 
 	return autoscalings.Groups[0].TargetGroups, nil
@@ -700,14 +835,18 @@ func (s *AutoScalingService) ListTargetgroups(autoscalingId string) ([]AutoScali
 
 func (s *AutoScalingService) DeleteTargetgroup(autoScalingeId, autoScalingTargetgroupId string) (*DeleteResponse, error) {
 	reqUrl := "autoscaling/" + autoScalingeId + "/targetgroup/" + autoScalingTargetgroupId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -119,7 +119,7 @@ func TestAutoScalingService_List_invalidServer(t *testing.T) {
 func TestAutoScalingService_Delete_happyPath(t *testing.T) {
 	token := "token"
 	autoscalingId := "11111"
-	autoscalingName := "Auto-scaling-Jz8hceLN.utho"
+	autoscalingName := "Auto scaling Jz8hceLN&utho"
 
 	client, mux, _, teardown := setup(token)
 	defer teardown()
@@ -127,6 +127,9 @@ func TestAutoScalingService_Delete_happyPath(t *testing.T) {
 	mux.HandleFunc("/autoscaling/"+autoscalingId, func(w http.ResponseWriter, req *http.Request) {
 		testHttpMethod(t, req, "DELETE")
 		testHeader(t, req, "Authorization", "Bearer "+token)
+		if got := req.URL.Query().Get("name"); got != autoscalingName {
+			t.Errorf("name = %q, want %q", got, autoscalingName)
+		}
 		fmt.Fprint(w, dummyDeleteResponseJson)
 	})
 
@@ -150,6 +153,40 @@ func TestAutoScalingService_DeleteAutoScaling_invalidServer(t *testing.T) {
 	}
 }
 
+func TestAutoScalingService_ListInstances_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/autoscaling/11111", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprint(w, dummyAutoScalingServerRes)
+	})
+
+	got, err := client.AutoScaling().ListInstances("11111")
+
+	want := []Instances{{
+		Cloudid:   "1277770",
+		Hostname:  "Auto-scaling-Jz8hceLN.utho-instance-yrlsz",
+		CreatedAt: "2024-05-11 22:35:24",
+		IP:        "103.150.136.128",
+		Status:    "Active",
+	}}
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestAutoScalingService_ListInstances_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	instances, err := client.AutoScaling().ListInstances("11111")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if instances != nil {
+		t.Errorf("Was not expecting any instances to be returned, instead got %v", instances)
+	}
+}
+
 // autoscaling Policy
 func TestAutoScalingService_CreatePolicy_happyPath(t *testing.T) {
 	token := "token"
@@ -865,3 +902,67 @@ func TestAutoScalingService_DeleteTargetgroup_invalidServer(t *testing.T) {
 		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
 	}
 }
+
+func TestValidateAutoScalingSizes(t *testing.T) {
+	tests := []struct {
+		name                      string
+		minsize, maxsize, desired string
+		wantErr                   string
+	}{
+		{name: "within bounds", minsize: "1", maxsize: "5", desired: "3"},
+		{name: "desired at bounds", minsize: "2", maxsize: "2", desired: "2"},
+		{name: "empty sizes are skipped", maxsize: "4"},
+		{name: "min above max", minsize: "5", maxsize: "1", wantErr: "minsize 5 must not be greater than maxsize 1"},
+		{name: "desired below min", minsize: "2", maxsize: "5", desired: "1", wantErr: "desiredsize 1 must not be less than minsize 2"},
+		{name: "desired above max", minsize: "1", maxsize: "5", desired: "6", wantErr: "desiredsize 6 must not be greater than maxsize 5"},
+		{name: "not a number", minsize: "one", wantErr: `minsize must be a number, got "one"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAutoScalingSizes(tt.minsize, tt.maxsize, tt.desired)
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAutoScalingService_Create_invalidSizes(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.AutoScaling().Create(CreateAutoScalingParams{Minsize: "3", Maxsize: "5", Desiredsize: "1"})
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestAutoScalingService_CreateSchedule_sendsRecurrence(t *testing.T) {
+	token := "token"
+	payload := CreateAutoScalingScheduleParams{
+		AutoScalingId: "11111",
+		Name:          "nightly",
+		Desiredsize:   "1",
+		Recurrence:    "0 22 * * *",
+		StartDate:     "2024-07-01 22:00:00",
+	}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/autoscaling/"+payload.AutoScalingId+"/schedulepolicy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.NotContains(t, got, "AutoScalingId")
+		assert.Equal(t, "0 22 * * *", got["recurrence"])
+
+		fmt.Fprint(w, dummyCreateResponseJson)
+	})
+
+	_, err := client.AutoScaling().CreateSchedule(payload)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,85 @@
+package utho
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CallOption describes a functional parameter that tunes a single API call
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	timeout        time.Duration
+	maxRetries     *int
+	idempotencyKey string
+}
+
+type callOptionsKey struct{}
+
+// WithCallTimeout bounds a single call, including the in-flight HTTP request,
+// without having to manage a context. It is accepted by the CloudInstancesService
+// Create, CreateMultiple, Read, Delete and power actions.
+func WithCallTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = d
+	}
+}
+
+// WithCallMaxRetries overrides the retry count set with WithRetry for a single call.
+// Use 0 to disable retries, e.g. for health probes. Without WithRetry on the client,
+// retries back off exponentially from 500ms.
+func WithCallMaxRetries(n int) CallOption {
+	return func(o *callOptions) {
+		o.maxRetries = &n
+	}
+}
+
+// idempotencyKeyHeader carries the key set with WithIdempotencyKey
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey sends key in the Idempotency-Key header, as a hint for tracing repeated requests.
+// The API doesn't document deduplicating on it, so a keyed POST is still retried only when the
+// connection could not be established, see DefaultRetryPredicate.
+// It is accepted by CloudInstancesService.Create; generate keys with NewIdempotencyKey and
+// reuse the same key when repeating a create.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// NewIdempotencyKey returns a random UUID (version 4) for use with WithIdempotencyKey
+func NewIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// applyCallOptions returns a copy of req carrying the given call options.
+// The returned cancel func must be called once the call has completed.
+func applyCallOptions(req *http.Request, opts []CallOption) (*http.Request, context.CancelFunc) {
+	o := callOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	ctx, cancel := context.WithValue(req.Context(), callOptionsKey{}, o), context.CancelFunc(func() {})
+	if o.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+	}
+
+	req = req.Clone(ctx)
+	if o.idempotencyKey != "" {
+		req.Header.Set(idempotencyKeyHeader, o.idempotencyKey)
+	}
+
+	return req, cancel
+}
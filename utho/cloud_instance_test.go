@@ -1,11 +1,15 @@
 package utho
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -34,6 +38,111 @@ func TestCloudInstanceService_Create_happyPath(t *testing.T) {
 	assert.Equal(t, want, *got)
 }
 
+func TestCloudInstanceService_Create_locationHeader(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		w.Header().Set("Location", "/v2/cloud/1111111")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	got, err := client.CloudInstances().Create(payload)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "/v2/cloud/1111111", got.Location)
+}
+
+func TestCloudInstanceService_Create_idempotencyKey(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	key, err := NewIdempotencyKey()
+	assert.Nil(t, err)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, key)
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Idempotency-Key", key)
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	_, err = client.CloudInstances().Create(payload, WithIdempotencyKey(key))
+
+	assert.Nil(t, err)
+}
+
+func TestCloudInstanceService_CreateMultiple_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+
+		var body CreateCloudInstanceParams
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, []CloudHostname{{Hostname: "web-1"}, {Hostname: "web-2"}}, body.Cloud)
+
+		fmt.Fprint(w, `{"status":"success","message":"deploying","cloud":[
+			{"cloudid":"1","password":"p1","ipv4":"10.0.0.1"},
+			{"cloudid":"2","password":"p2","ipv4":"10.0.0.2"}
+		]}`)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+	payload.Cloud = []CloudHostname{{Hostname: "web-1"}, {Hostname: "web-2"}}
+
+	got, err := client.CloudInstances().CreateMultiple(payload)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CreateCloudInstanceResponse{
+		{ID: "1", Password: "p1", Ipv4: "10.0.0.1"},
+		{ID: "2", Password: "p2", Ipv4: "10.0.0.2"},
+	}, got)
+}
+
+func TestCloudInstanceService_CreateMultiple_singleInstance(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+	payload.Cloud = []CloudHostname{{Hostname: "web-1"}}
+
+	got, err := client.CloudInstances().CreateMultiple(payload)
+
+	var want CreateCloudInstanceResponse
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CreateCloudInstanceResponse{want}, got)
+}
+
+func TestCloudInstanceService_CreateMultiple_noHostnames(t *testing.T) {
+	client, _ := NewClient("token")
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+	payload.Cloud = nil
+
+	_, err := client.CloudInstances().CreateMultiple(payload)
+
+	assert.EqualError(t, err, "at least one cloud hostname is required")
+}
+
 func TestCloudInstanceService_Create_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
@@ -78,6 +187,44 @@ func TestCloudInstanceService_Read_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_ListFirewalls_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	serverResponse := `{"cloud":[{"cloudid":"someId","firewalls":[
+		{"id":"1","name":"base","created_at":"2024-01-01 10:00:00"},
+		{"id":"2","name":"app","created_at":"2024-01-02 10:00:00"}
+	]}],"status":"success"}`
+
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, serverResponse)
+	})
+
+	want := []CloudInstanceFirewalls{
+		{ID: "1", Name: "base", CreatedAt: "2024-01-01 10:00:00"},
+		{ID: "2", Name: "app", CreatedAt: "2024-01-02 10:00:00"},
+	}
+
+	got, err := client.CloudInstances().ListFirewalls(ID)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestCloudInstanceService_ListFirewalls_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	firewalls, err := client.CloudInstances().ListFirewalls("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if firewalls != nil {
+		t.Errorf("Was not expecting any firewalls to be returned, instead got %v", firewalls)
+	}
+}
+
 func TestCloudInstanceService_List_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
@@ -116,6 +263,56 @@ func TestCloudInstanceService_List_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_Update_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID+"/hostname", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer token")
+
+		var params UpdateCloudInstanceParams
+		_ = json.NewDecoder(req.Body).Decode(&params)
+		assert.Equal(t, "web-01", params.Hostname)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","hostname":"web-01"}],"status":"success"}`, ID)
+	})
+
+	got, err := client.CloudInstances().Update(ID, UpdateCloudInstanceParams{Hostname: "web-01"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "web-01", got.Hostname)
+}
+
+func TestCloudInstanceService_Update_emptyHostname(t *testing.T) {
+	client, _ := NewClient("token")
+
+	cloudInstance, err := client.CloudInstances().Update("someId", UpdateCloudInstanceParams{})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if cloudInstance != nil {
+		t.Errorf("Was not expecting any cloudinstance to be returned, instead got %v", cloudInstance)
+	}
+}
+
+func TestCloudInstanceService_Update_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	cloudInstance, err := client.CloudInstances().Update("someId", UpdateCloudInstanceParams{Hostname: "web-01"})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if cloudInstance != nil {
+		t.Errorf("Was not expecting any cloudinstance to be returned, instead got %v", cloudInstance)
+	}
+}
+
 func TestCloudInstanceService_Delete_happyPath(t *testing.T) {
 	token := "token"
 	cloudInstanceId := "someCloudInstanceId"
@@ -138,6 +335,61 @@ func TestCloudInstanceService_Delete_happyPath(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_Delete_callTimeout(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someCloudInstanceId/destroy", func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second):
+		}
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	delResponse, err := client.CloudInstances().Delete("someCloudInstanceId", DeleteCloudInstanceParams{}, WithCallTimeout(10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, instead got %v", err)
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
+func TestCloudInstanceService_callTimeout(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	slow := func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second):
+		}
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	}
+	mux.HandleFunc("/cloud/someId", slow)
+	mux.HandleFunc("/cloud/someId/poweron", slow)
+	mux.HandleFunc("/cloud/someId/poweroff", slow)
+	mux.HandleFunc("/cloud/someId/powercycle", slow)
+	mux.HandleFunc("/cloud/someId/hardreboot", slow)
+
+	instances := client.CloudInstances()
+	_, err := instances.Read("someId", WithCallTimeout(10*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	for name, call := range map[string]func(string, ...CallOption) (*BasicResponse, error){
+		"PowerOn":    instances.PowerOn,
+		"PowerOff":   instances.PowerOff,
+		"PowerCycle": instances.PowerCycle,
+		"HardReboot": instances.HardReboot,
+	} {
+		start := time.Now()
+		_, err := call("someId", WithCallTimeout(10*time.Millisecond))
+		assert.ErrorIs(t, err, context.DeadlineExceeded, name)
+		assert.Less(t, time.Since(start), 500*time.Millisecond, name)
+	}
+}
+
 func TestCloudInstanceService_Delete_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
@@ -150,6 +402,49 @@ func TestCloudInstanceService_Delete_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_DeleteMany_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	var mu sync.Mutex
+	var deleted []string
+	for _, id := range []string{"1", "2", "3"} {
+		id := id
+		mux.HandleFunc("/cloud/"+id+"/destroy", func(w http.ResponseWriter, req *http.Request) {
+			testHttpMethod(t, req, http.MethodDelete)
+			mu.Lock()
+			deleted = append(deleted, id)
+			mu.Unlock()
+			fmt.Fprint(w, dummyDeleteResponseJson)
+		})
+	}
+	mux.HandleFunc("/cloud/4/destroy", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":"error","message":"Cloud server not found"}`)
+	})
+
+	err := client.CloudInstances().DeleteMany([]string{"1", "2", "3", "4"}, DeleteCloudInstanceParams{
+		Confirm: "I am aware this action will delete data and server permanently",
+	})
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorContains(t, err, "instance 4:")
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, deleted)
+}
+
+func TestCloudInstanceService_DeleteMany_invalidConfirm(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/1/destroy", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Was not expecting a request to be made")
+	})
+
+	err := client.CloudInstances().DeleteMany([]string{"1"}, DeleteCloudInstanceParams{Confirm: "yes"})
+
+	assert.ErrorContains(t, err, "confirm must be")
+}
+
 func TestCloudInstanceService_ListOsImages_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
@@ -188,6 +483,44 @@ func TestCloudInstanceService_ListOsImages_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_ListPlans_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	expectedResponse := dummyListResizePlansRes
+	serverResponse := dummyListResizePlansServerRes
+
+	mux.HandleFunc("/cloud/plans", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, serverResponse)
+	})
+
+	var want []Plan
+	_ = json.Unmarshal([]byte(expectedResponse), &want)
+
+	got, _ := client.CloudInstances().ListPlans()
+	if len(got) != len(want) {
+		t.Errorf("Was expecting %d plans to be returned, instead got %d", len(want), len(got))
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, want %v", got, want)
+	}
+}
+
+func TestCloudInstanceService_ListPlans_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	plans, err := client.CloudInstances().ListPlans()
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if plans != nil {
+		t.Errorf("Was not expecting any plans to be returned, instead got %v", plans)
+	}
+}
+
 func TestCloudInstanceService_ListResizePlans_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
@@ -227,6 +560,69 @@ func TestCloudInstanceService_ListResizePlans_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCloudInstanceService_ListUpgradePlans_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","planid":"10045","vmcost":1.5}],"status":"success"}`, instanceId)
+	})
+	mux.HandleFunc("/cloud/plans", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprint(w, `{"plans":[
+			{"id":"10044","price":5,"monthly":10},
+			{"id":"10045","price":900,"monthly":20.25}
+		],"status":"success"}`)
+	})
+	mux.HandleFunc("/cloud/"+instanceId+"/resizeplans", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprint(w, `{"plans":[
+			{"id":"10027","price":30.59,"monthly":30.5},
+			{"id":"10028","price":61.25,"monthly":51}
+		],"status":"success"}`)
+	})
+
+	got, err := client.CloudInstances().ListUpgradePlans(instanceId)
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "10027", got[0].ID)
+	assert.Equal(t, 10.25, got[0].PriceDelta)
+	assert.Equal(t, "10028", got[1].ID)
+	assert.Equal(t, 30.75, got[1].PriceDelta)
+}
+
+func TestCloudInstanceService_ListUpgradePlans_unknownCurrentPlan(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"someId","planid":"99999"}],"status":"success"}`)
+	})
+	mux.HandleFunc("/cloud/plans", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyListResizePlansServerRes)
+	})
+
+	plans, err := client.CloudInstances().ListUpgradePlans("someId")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, plans)
+}
+
+func TestCloudInstanceService_ListUpgradePlans_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	plans, err := client.CloudInstances().ListUpgradePlans("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if plans != nil {
+		t.Errorf("Was not expecting any plans to be returned, instead got %v", plans)
+	}
+}
+
 func TestCloudInstanceService_CreateSnapshot_happyPath(t *testing.T) {
 	token := "token"
 	instanceId := "someId"
@@ -258,29 +654,65 @@ func TestCloudInstanceService_CreateSnapshot_invalidServer(t *testing.T) {
 	}
 }
 
-func TestCloudInstanceService_DeleteSnapshot_happyPath(t *testing.T) {
+func TestCloudInstanceService_CreateSnapshotWithParams_happyPath(t *testing.T) {
 	token := "token"
-	cloudInstanceId := "someCloudInstanceId"
-	snapshotId := "somesnapshotId"
+	instanceId := "someId"
 
 	client, mux, _, teardown := setup(token)
 	defer teardown()
 
-	mux.HandleFunc("/cloud/"+cloudInstanceId+"/snapshot/"+snapshotId+"/delete", func(w http.ResponseWriter, req *http.Request) {
-		testHttpMethod(t, req, "DELETE")
+	mux.HandleFunc("/cloud/"+instanceId+"/snapshot/create", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
 		testHeader(t, req, "Authorization", "Bearer "+token)
-		fmt.Fprint(w, dummyDeleteResponseJson)
+
+		var body map[string]interface{}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "before-upgrade", "lock": true}, body)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
 	})
 
-	want := DeleteResponse{Status: "success", Message: "success"}
+	got, err := client.CloudInstances().CreateSnapshotWithParams(instanceId, CreateSnapshotParams{Name: "before-upgrade", Lock: true})
 
-	got, _ := client.CloudInstances().DeleteSnapshot(cloudInstanceId, snapshotId)
-	if !reflect.DeepEqual(*got, want) {
-		t.Errorf("Response = %v, want %v", *got, want)
-	}
+	var want CreateBasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
 }
 
-func TestCloudInstanceService_DeleteSnapshot_invalidServer(t *testing.T) {
+func TestCloudInstanceService_CreateSnapshotWithParams_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().CreateSnapshotWithParams("instanceId", CreateSnapshotParams{Name: "snap"})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestCloudInstanceService_DeleteSnapshot_happyPath(t *testing.T) {
+	token := "token"
+	cloudInstanceId := "someCloudInstanceId"
+	snapshotId := "somesnapshotId"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/"+cloudInstanceId+"/snapshot/"+snapshotId+"/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "DELETE")
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, _ := client.CloudInstances().DeleteSnapshot(cloudInstanceId, snapshotId)
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("Response = %v, want %v", *got, want)
+	}
+}
+
+func TestCloudInstanceService_DeleteSnapshot_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
 	delResponse, err := client.CloudInstances().Delete("someCloudInstanceId", DeleteCloudInstanceParams{})
@@ -610,3 +1042,814 @@ func TestCloudInstanceService_RestoreSnapshot_invalidServer(t *testing.T) {
 		t.Errorf("Expected error to be returned")
 	}
 }
+
+func TestCloudInstanceService_ListSnapshots_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","snapshots":[
+			{"id":"s1","size":"20","created_at":"2024-05-02 10:00:00","note":"","name":"before-upgrade"}
+		]}],"status":"success"}`, ID)
+	})
+
+	got, err := client.CloudInstances().ListSnapshots(ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Snapshot{{ID: "s1", Size: "20", CreatedAt: "2024-05-02 10:00:00", Name: "before-upgrade"}}, got)
+}
+
+func TestCloudInstanceService_ListSnapshots_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	snapshots, err := client.CloudInstances().ListSnapshots("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if snapshots != nil {
+		t.Errorf("Was not expecting any snapshots to be returned, instead got %v", snapshots)
+	}
+}
+
+func TestCloudInstanceService_Migrate_happyPath(t *testing.T) {
+	token := "token"
+	instanceId := "someId"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","dclocation":{"dc":"inmumbaizone2"}}],"status":"success"}`, instanceId)
+	})
+	mux.HandleFunc("/cloud/"+instanceId+"/migrate", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var body MigrateCloudInstanceParams
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, "indelhizone1", body.Dcslug)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.CloudInstances().Migrate(instanceId, "indelhizone1")
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_Migrate_sameDatacenter(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","dclocation":{"dc":"inmumbaizone2"}}],"status":"success"}`, instanceId)
+	})
+	mux.HandleFunc("/cloud/"+instanceId+"/migrate", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Was not expecting a migrate request")
+	})
+
+	got, err := client.CloudInstances().Migrate(instanceId, "inmumbaizone2")
+
+	assert.EqualError(t, err, "cloud instance someId is already in inmumbaizone2")
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_Migrate_notSupported(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","dclocation":{"dc":"inmumbaizone2"}}],"status":"success"}`, instanceId)
+	})
+	mux.HandleFunc("/cloud/"+instanceId+"/migrate", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Migration is not available for this plan"}`)
+	})
+
+	got, err := client.CloudInstances().Migrate(instanceId, "indelhizone1")
+
+	assert.ErrorContains(t, err, "Migration is not available for this plan")
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_Migrate_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().Migrate("someId", "indelhizone1")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestCloudInstanceService_ListBackups_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","backups":[
+			{"id":"b1","size":"10","created_at":"2024-05-01 02:00:00","type":"auto"}
+		]}],"status":"success"}`, ID)
+	})
+
+	got, err := client.CloudInstances().ListBackups(ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Backup{{ID: "b1", Size: "10", CreatedAt: "2024-05-01 02:00:00", Type: "auto"}}, got)
+}
+
+func TestCloudInstanceService_ListBackups_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	backups, err := client.CloudInstances().ListBackups("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if backups != nil {
+		t.Errorf("Was not expecting any backups to be returned, instead got %v", backups)
+	}
+}
+
+func TestCloudInstanceService_RestoreBackup_happyPath(t *testing.T) {
+	token := "token"
+	instanceId := "someId"
+	backupId := "backupId"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/"+instanceId+"/backups/"+backupId+"/restore", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.CloudInstances().RestoreBackup(instanceId, backupId)
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_RestoreBackup_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().RestoreBackup("instanceId", "backupId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestCloudInstance_statusAccessors(t *testing.T) {
+	cloudInstance := CloudInstance{Status: "Active", Powerstatus: "Stopped"}
+
+	assert.Equal(t, InstanceStatusActive, cloudInstance.InstanceStatus())
+	assert.Equal(t, InstanceStatusStopped, cloudInstance.PowerStatus())
+	assert.Equal(t, "Active", InstanceStatusActive.String())
+}
+
+func TestCloudInstanceService_Provision_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+	polls := 0
+	mux.HandleFunc("/cloud/1111111", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		status := "Pending"
+		if polls == 2 {
+			status = "Active"
+		}
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"1111111","status":%q}],"status":"success"}`, status)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	got, credentials, err := client.CloudInstances().Provision(context.Background(), payload, WithPollInterval(time.Millisecond))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Active", got.Status)
+	assert.Equal(t, &InstanceCredentials{IP: "210.210.210.210", Password: "qwertuioo@111"}, credentials)
+	assert.Equal(t, 2, polls)
+}
+
+func TestCloudInstanceService_Provision_timeout(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+	mux.HandleFunc("/cloud/1111111", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"1111111","status":"Pending"}],"status":"success"}`)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	got, credentials, err := client.CloudInstances().Provision(context.Background(), payload,
+		WithPollInterval(5*time.Millisecond), WithWaitTimeout(20*time.Millisecond))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, &CloudInstance{ID: "1111111", IP: "210.210.210.210"}, got)
+	assert.Equal(t, &InstanceCredentials{IP: "210.210.210.210", Password: "qwertuioo@111"}, credentials)
+}
+
+func TestCloudInstanceService_Provision_createFails(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status":"error","message":"Insufficient balance"}`)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	got, credentials, err := client.CloudInstances().Provision(context.Background(), payload, WithPollInterval(time.Millisecond))
+
+	assert.ErrorContains(t, err, "Insufficient balance")
+	assert.Nil(t, got)
+	assert.Nil(t, credentials)
+}
+
+func TestCloudInstance_Created(t *testing.T) {
+	cloudInstance := CloudInstance{CreatedAt: "2024-05-01 10:30:00", Snapshots: []Snapshots{{CreatedAt: "2024-05-02 08:00:00"}}}
+
+	created, err := cloudInstance.Created()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), created)
+
+	updated, err := cloudInstance.Updated()
+	assert.Nil(t, err)
+	assert.True(t, updated.IsZero())
+
+	taken, err := cloudInstance.Snapshots[0].Created()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC), taken)
+}
+
+func TestCloudInstanceService_WaitForStatus_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	polls := 0
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		polls++
+		status, powerstatus := "Pending", "Stopped"
+		if polls == 3 {
+			status, powerstatus = "Active", "Running"
+		}
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","status":"%s","powerstatus":"%s"}],"status":"success"}`, ID, status, powerstatus)
+	})
+
+	targets := []InstanceStatus{InstanceStatusActive}
+	got, err := client.CloudInstances().WaitForStatus(context.Background(), ID, targets, WithPollInterval(time.Millisecond))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Active", got.Status)
+	assert.Equal(t, 3, polls)
+}
+
+func TestCloudInstanceService_WaitForStatus_powerStatus(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","status":"Active","powerstatus":"Stopped"}],"status":"success"}`, ID)
+	})
+
+	targets := []InstanceStatus{InstanceStatusStopped, InstanceStatusPending}
+	got, err := client.CloudInstances().WaitForStatus(context.Background(), ID, targets, WithPollInterval(time.Millisecond))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Stopped", got.Powerstatus)
+}
+
+func TestCloudInstanceService_WaitForStatus_failureStatus(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","status":"Suspended","powerstatus":"Stopped"}],"status":"success"}`, ID)
+	})
+
+	targets := []InstanceStatus{InstanceStatusActive}
+	got, err := client.CloudInstances().WaitForStatus(context.Background(), ID, targets, WithPollInterval(time.Millisecond))
+
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_WaitForStatus_contextCancelled(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","status":"Pending","powerstatus":"Stopped"}],"status":"success"}`, ID)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	targets := []InstanceStatus{InstanceStatusActive}
+	got, err := client.CloudInstances().WaitForStatus(ctx, ID, targets, WithPollInterval(time.Millisecond))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_Read_invalidRequest(t *testing.T) {
+	client, _, _, teardown := setup("token")
+	defer teardown()
+
+	cloudInstance, err := client.CloudInstances().Read("%zz")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if cloudInstance != nil {
+		t.Errorf("Was not expecting any cloudinstance to be returned, instead got %v", cloudInstance)
+	}
+}
+
+func TestCloudInstanceService_ListPage_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		assert.Equal(t, "2", req.URL.Query().Get("page"))
+		assert.Equal(t, "1", req.URL.Query().Get("per_page"))
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"2"}],"meta":{"total":3,"totalpages":3,"currentpage":2},"status":"success"}`)
+	})
+
+	got, meta, err := client.CloudInstances().ListPage(ListOptions{Page: 2, PerPage: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CloudInstance{{ID: "2"}}, got)
+	assert.Equal(t, Meta{Total: 3, Totalpages: 3, Currentpage: 2}, *meta)
+}
+
+func TestCloudInstanceService_ListFiltered_byTag(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		assert.Equal(t, "staging", req.URL.Query().Get("tag"))
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"1","tags":["staging","web"]}],"status":"success"}`)
+	})
+
+	got, err := client.CloudInstances().ListFiltered(CloudInstanceListFilter{Tag: "staging"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CloudInstance{{ID: "1", Tags: []string{"staging", "web"}}}, got)
+}
+
+func TestCloudInstanceService_ListFiltered_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		query := req.URL.Query()
+		assert.Equal(t, "inmumbaizone2", query.Get("dcslug"))
+		assert.Equal(t, "Active", query.Get("status"))
+		assert.Equal(t, "staging", query.Get("tag"))
+		assert.Equal(t, "2", query.Get("page"))
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"1"}],"status":"success"}`)
+	})
+
+	got, err := client.CloudInstances().ListFiltered(CloudInstanceListFilter{
+		DCSlug: "inmumbaizone2",
+		Status: InstanceStatusActive,
+		Tag:    "staging",
+	}, ListOptions{Page: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CloudInstance{{ID: "1"}}, got)
+}
+
+func TestCloudInstanceService_ListFiltered_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	cloudInstances, err := client.CloudInstances().ListFiltered(CloudInstanceListFilter{Tag: "staging"})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if cloudInstances != nil {
+		t.Errorf("Was not expecting any cloudinstances to be returned, instead got %v", cloudInstances)
+	}
+}
+
+func TestCloudInstanceService_AddTags_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId+"/tags/add", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer token")
+
+		var body CloudInstanceTagsParams
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, []string{"staging", "web"}, body.Tags)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.CloudInstances().AddTags(instanceId, []string{"staging", "web"})
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_RemoveTags_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId+"/tags/remove", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+
+		var body CloudInstanceTagsParams
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, []string{"web"}, body.Tags)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	_, err := client.CloudInstances().RemoveTags(instanceId, []string{"web"})
+
+	assert.Nil(t, err)
+}
+
+func TestCloudInstanceService_AddTags_noTags(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().AddTags("someId", nil)
+
+	assert.EqualError(t, err, "at least one tag is required")
+}
+
+func TestCloudInstanceService_AddTags_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().AddTags("someId", []string{"staging"})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestCloudInstanceService_ListAll_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s"}],"meta":{"total":2,"totalpages":2,"currentpage":%s},"status":"success"}`, page, page)
+	})
+
+	got, err := client.CloudInstances().ListAll(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []CloudInstance{{ID: "1"}, {ID: "2"}}, got)
+}
+
+func TestCloudInstanceService_ListAll_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	cloudinstance, err := client.CloudInstances().ListAll(0)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if cloudinstance != nil {
+		t.Errorf("Was not expecting any cloudinstance to be returned, instead got %v", cloudinstance)
+	}
+}
+
+func TestCloudInstanceService_WaitForStatus_apiError(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	ID := "someId"
+	mux.HandleFunc("/cloud/"+ID, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	targets := []InstanceStatus{InstanceStatusActive}
+	got, err := client.CloudInstances().WaitForStatus(context.Background(), ID, targets, WithPollInterval(time.Millisecond))
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, http.StatusInternalServerError, errorResponse.StatusCode)
+	assert.False(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_GetConsoleURL_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	serverResponse := `{"url":"https://console.utho.com/vnc/abc","expires_at":"2024-05-01 10:05:00","status":"success"}`
+
+	mux.HandleFunc("/cloud/"+instanceId+"/console", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, serverResponse)
+	})
+
+	var want ConsoleURL
+	_ = json.Unmarshal([]byte(serverResponse), &want)
+
+	got, err := client.CloudInstances().GetConsoleURL(instanceId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_GetConsoleURL_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	consoleURL, err := client.CloudInstances().GetConsoleURL("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if consoleURL != nil {
+		t.Errorf("Was not expecting any console url to be returned, instead got %v", consoleURL)
+	}
+}
+
+func TestCloudInstanceService_SetReverseDNS_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	ip := "201.201.201.201"
+	mux.HandleFunc("/cloud/"+instanceId+"/updaterdns/"+ip, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer token")
+
+		var params UpdateReverseDNSParams
+		_ = json.NewDecoder(req.Body).Decode(&params)
+		assert.Equal(t, "mail.example.com", params.Rdns)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.CloudInstances().SetReverseDNS(instanceId, ip, "mail.example.com")
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_SetReverseDNS_ipNotAttached(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId/updaterdns/10.0.0.1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"IP not found on this cloud server"}`)
+	})
+
+	got, err := client.CloudInstances().SetReverseDNS("someId", "10.0.0.1", "mail.example.com")
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, "IP not found on this cloud server", errorResponse.Message)
+	assert.Nil(t, got)
+}
+
+func TestCloudInstanceService_GetReverseDNS_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","networks":{"public":{"v4":[
+			{"ip_address":"201.201.201.201","rdns":"mail.example.com"}
+		]}}}],"status":"success"}`, instanceId)
+	})
+
+	got, err := client.CloudInstances().GetReverseDNS(instanceId, "201.201.201.201")
+	assert.Nil(t, err)
+	assert.Equal(t, "mail.example.com", got)
+
+	_, err = client.CloudInstances().GetReverseDNS(instanceId, "10.0.0.1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCloudInstanceService_ListPublicIPs_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","networks":{"public":{"v4":[
+			{"ip_address":"201.201.201.201","primary":"1"},
+			{"ip_address":"201.201.201.202","primary":"0"}
+		]}}}],"status":"success"}`, instanceId)
+	})
+
+	got, err := client.CloudInstances().ListPublicIPs(instanceId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []V4Public{
+		{IPAddress: "201.201.201.201", Primary: "1"},
+		{IPAddress: "201.201.201.202", Primary: "0"},
+	}, got)
+}
+
+func TestCloudInstanceService_AssignPublicIP_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	mux.HandleFunc("/cloud/"+instanceId+"/publicip/assign", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.CloudInstances().AssignPublicIP(instanceId)
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestCloudInstanceService_AssignPublicIP_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.CloudInstances().AssignPublicIP("someId")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestCloudInstanceService_DeletePublicIP_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	instanceId := "someId"
+	ip := "201.201.201.202"
+	mux.HandleFunc("/cloud/"+instanceId+"/publicip/"+ip+"/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "DELETE")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, _ := client.CloudInstances().DeletePublicIP(instanceId, ip)
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("Response = %v, want %v", *got, want)
+	}
+}
+
+func TestCloudInstanceService_DeletePublicIP_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	delResponse, err := client.CloudInstances().DeletePublicIP("someId", "201.201.201.202")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
+func TestCreateCloudInstanceParams_Validate(t *testing.T) {
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+	assert.Nil(t, payload.Validate())
+
+	err := CreateCloudInstanceParams{Cloud: []CloudHostname{{}}}.Validate()
+	assert.EqualError(t, err, "missing required fields: dcslug, planid, image, cloud[0].hostname")
+
+	fromSnapshot := CreateCloudInstanceParams{Dcslug: "innoida", Planid: "10045", Snapshotid: "123"}
+	assert.Nil(t, fromSnapshot.Validate())
+}
+
+func TestCreateCloudInstanceParams_Validate_crossFieldRules(t *testing.T) {
+	base := CreateCloudInstanceParams{Dcslug: "innoida", Planid: "10045", Image: "ubuntu-22.04-x86_64"}
+
+	tests := []struct {
+		name    string
+		modify  func(p *CreateCloudInstanceParams)
+		wantErr string
+	}{
+		{"firewall list", func(p *CreateCloudInstanceParams) { p.Firewall = "123,456" }, ""},
+		{"sshkeys list", func(p *CreateCloudInstanceParams) { p.Sshkeys = "1" }, ""},
+		{"stack with fields", func(p *CreateCloudInstanceParams) {
+			p.Stackid = "7"
+			p.StackFields = map[string]string{"domain": "example.com"}
+		}, ""},
+		{"snapshot and backup", func(p *CreateCloudInstanceParams) {
+			p.Snapshotid = "123"
+			p.Backupid = "456"
+		}, "snapshotid and backupid can't both be set"},
+		{"empty firewall entry", func(p *CreateCloudInstanceParams) { p.Firewall = "123," }, `firewall must be a comma separated list of IDs, got "123,"`},
+		{"blank sshkeys", func(p *CreateCloudInstanceParams) { p.Sshkeys = " " }, `sshkeys must be a comma separated list of IDs, got " "`},
+		{"stack fields without stack", func(p *CreateCloudInstanceParams) {
+			p.StackFields = map[string]string{"domain": "example.com"}
+		}, "stack_fields can only be set along with stack"},
+	}
+
+	for _, tt := range tests {
+		params := base
+		tt.modify(&params)
+
+		err := params.Validate()
+		if tt.wantErr == "" {
+			assert.Nil(t, err, tt.name)
+		} else {
+			assert.EqualError(t, err, tt.wantErr, tt.name)
+		}
+	}
+}
+
+func TestCloudInstanceService_CheckVpcDatacenter(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/vpc", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		fmt.Fprint(w, `{"status":"success","vpc":[{"id":"11111","dcslug":"inmumbaizone2"}]}`)
+	})
+
+	params := CreateCloudInstanceParams{Dcslug: "innoida", Planid: "10045", Image: "ubuntu-22.04-x86_64", Vpc: "11111"}
+	err := client.CloudInstances().CheckVpcDatacenter(params)
+	assert.EqualError(t, err, "vpc 11111 is in datacenter inmumbaizone2, the instance can't be deployed to innoida")
+
+	params.Vpc = "22222"
+	err = client.CloudInstances().CheckVpcDatacenter(params)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	params.Vpc = "11111"
+	params.Dcslug = "inmumbaizone2"
+	assert.Nil(t, client.CloudInstances().CheckVpcDatacenter(params))
+
+	params.Vpc = ""
+	assert.Nil(t, client.CloudInstances().CheckVpcDatacenter(params))
+}
+
+func TestCloudInstanceService_Create_skipsVpcLookup(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/vpc", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Create must not read the vpc")
+	})
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyCreateCloudInstanceResponseJson)
+	})
+
+	params := CreateCloudInstanceParams{Dcslug: "innoida", Planid: "10045", Image: "ubuntu-22.04-x86_64", Vpc: "11111"}
+	_, err := client.CloudInstances().Create(params)
+	assert.Nil(t, err)
+}
+
+func TestCloudInstanceService_Create_invalidParams(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("invalid params should not be sent")
+	})
+
+	got, err := client.CloudInstances().Create(CreateCloudInstanceParams{Dcslug: "innoida"})
+	assert.EqualError(t, err, "missing required fields: planid, image")
+	assert.Nil(t, got)
+}
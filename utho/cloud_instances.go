@@ -1,7 +1,14 @@
 package utho
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 )
 
 type CloudInstancesService service
@@ -27,6 +34,7 @@ type CloudInstance struct {
 	Iso               string                   `json:"iso,omitempty"`
 	IP                string                   `json:"ip"`
 	Billingcycle      string                   `json:"billingcycle"`
+	Planid            string                   `json:"planid"`
 	Cost              float64                  `json:"cost"`
 	Vmcost            float64                  `json:"vmcost"`
 	Imagecost         int                      `json:"imagecost"`
@@ -58,14 +66,26 @@ type CloudInstance struct {
 	DiskUsed          int                      `json:"disk_used"`
 	DiskFree          int                      `json:"disk_free"`
 	DiskUsedp         int                      `json:"disk_usedp"`
-	Backups           []any                    `json:"backups,omitempty"`
+	Backups           []Backup                 `json:"backups,omitempty"`
 	Snapshots         []Snapshots              `json:"snapshots,omitempty"`
 	Firewalls         []CloudInstanceFirewalls `json:"firewalls,omitempty"`
+	Tags              []string                 `json:"tags,omitempty"`
 	GpuAvailable      string                   `json:"gpu_available,omitempty"`
 	Gpus              []any                    `json:"gpus,omitempty"`
 	Snapshot          Snapshot                 `json:"snapshot,omitempty"`
 	Firewall          Firewall                 `json:"firewall,omitempty"`
 }
+
+// Created returns the time the instance was deployed at
+func (c CloudInstance) Created() (time.Time, error) {
+	return parseTimestamp(c.CreatedAt)
+}
+
+// Updated returns the time the instance was last changed at
+func (c CloudInstance) Updated() (time.Time, error) {
+	return parseTimestamp(c.UpdatedAt)
+}
+
 type Features struct {
 	Backups string `json:"backups"`
 }
@@ -133,6 +153,24 @@ type Snapshot struct {
 	Note      string `json:"note"`
 	Name      string `json:"name"`
 }
+
+// Created returns the time the snapshot was taken at
+func (s Snapshot) Created() (time.Time, error) {
+	return parseTimestamp(s.CreatedAt)
+}
+
+type Backup struct {
+	ID        string `json:"id"`
+	Size      string `json:"size"`
+	CreatedAt string `json:"created_at"`
+	Type      string `json:"type"`
+}
+
+// Created returns the time the backup was taken at
+func (b Backup) Created() (time.Time, error) {
+	return parseTimestamp(b.CreatedAt)
+}
+
 type CloudInstanceFirewall struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
@@ -150,6 +188,12 @@ type Snapshots struct {
 	Note      string `json:"note"`
 	Name      string `json:"name"`
 }
+
+// Created returns the time the snapshot was taken at
+func (s Snapshots) Created() (time.Time, error) {
+	return parseTimestamp(s.CreatedAt)
+}
+
 type CloudInstanceFirewalls struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
@@ -201,7 +245,68 @@ type CreateCloudInstanceParams struct {
 	Backupid     string          `json:"backupid,omitempty"`
 	Snapshotid   string          `json:"snapshotid,omitempty"`
 	Sshkeys      string          `json:"sshkeys,omitempty"`
+	Vpc          string          `json:"vpc,omitempty"`
 	Cloud        []CloudHostname `json:"cloud"`
+	// Stackid and StackFields run a stack with its variables on the new instance
+	Stackid     string            `json:"stack,omitempty"`
+	StackFields map[string]string `json:"stack_fields,omitempty"`
+}
+
+// Validate checks that the fields the API requires to deploy an instance are set,
+// and the constraints between fields the API would otherwise only report after the call:
+//   - the image may be omitted when deploying from a snapshot or a backup, but not both of them can be used
+//   - Firewall and Sshkeys are comma separated lists of IDs that must not hold empty entries
+//   - StackFields are only accepted along with Stackid
+//
+// Validate makes no requests, use CheckVpcDatacenter to also check the datacenter of Vpc.
+func (p CreateCloudInstanceParams) Validate() error {
+	fields := []requiredField{{"dcslug", p.Dcslug}, {"planid", p.Planid}}
+	if p.Snapshotid == "" && p.Backupid == "" {
+		fields = append(fields, requiredField{"image", p.Image})
+	}
+	for i, c := range p.Cloud {
+		fields = append(fields, requiredField{fmt.Sprintf("cloud[%d].hostname", i), c.Hostname})
+	}
+	if err := checkRequired(fields...); err != nil {
+		return err
+	}
+
+	if p.Snapshotid != "" && p.Backupid != "" {
+		return errors.New("snapshotid and backupid can't both be set")
+	}
+	for _, list := range []struct{ name, value string }{{"firewall", p.Firewall}, {"sshkeys", p.Sshkeys}} {
+		if list.value == "" {
+			continue
+		}
+		for _, id := range strings.Split(list.value, ",") {
+			if strings.TrimSpace(id) == "" {
+				return fmt.Errorf("%s must be a comma separated list of IDs, got %q", list.name, list.value)
+			}
+		}
+	}
+	if len(p.StackFields) > 0 && p.Stackid == "" {
+		return errors.New("stack_fields can only be set along with stack")
+	}
+
+	return nil
+}
+
+// CheckVpcDatacenter reads the VPC given in params and checks that it lives in the datacenter
+// the instance is deployed to. It is not called by Create, run it first to catch a mismatch before deploying.
+func (s *CloudInstancesService) CheckVpcDatacenter(params CreateCloudInstanceParams) error {
+	if params.Vpc == "" {
+		return nil
+	}
+
+	vpc, err := (*VpcService)(s).Read(params.Vpc)
+	if err != nil {
+		return fmt.Errorf("reading vpc %s: %w", params.Vpc, err)
+	}
+	if vpc.Dcslug != params.Dcslug {
+		return fmt.Errorf("vpc %s is in datacenter %s, the instance can't be deployed to %s", params.Vpc, vpc.Dcslug, params.Dcslug)
+	}
+
+	return nil
 }
 
 type CloudHostname struct {
@@ -214,57 +319,264 @@ type CreateCloudInstanceResponse struct {
 	Ipv4     string `json:"ipv4"`
 	Status   string `json:"status"`
 	Message  string `json:"message"`
+	// Location is taken from the Location response header, when the API
+	// returns one, and points at the new instance or its pending action.
+	Location string `json:"-"`
 }
 
-func (s *CloudInstancesService) Create(params CreateCloudInstanceParams) (*CreateCloudInstanceResponse, error) {
+// Create deploys new cloud instances.
+// A create that fails after being sent may still have deployed the instances, check with List before repeating it.
+func (s *CloudInstancesService) Create(params CreateCloudInstanceParams, opts ...CallOption) (*CreateCloudInstanceResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "cloud/deploy"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var cloudInstances CreateCloudInstanceResponse
-	_, err := s.client.Do(req, &cloudInstances)
+	resp, err := s.client.Do(req, &cloudInstances)
 	if err != nil {
 		return nil, err
 	}
 	if cloudInstances.Status != "success" && cloudInstances.Status != "" {
-		return nil, errors.New(cloudInstances.Message)
+		return nil, newErrorResponse(resp, cloudInstances.Message)
 	}
+	cloudInstances.Location = resp.Header.Get("Location")
 
 	return &cloudInstances, nil
 }
 
-func (s *CloudInstancesService) Read(instanceId string) (*CloudInstance, error) {
+// CreateCloudInstancesResponse is returned when a deploy creates several instances.
+// Each created instance is listed in Cloud, a deploy of a single instance is described by the embedded fields.
+type CreateCloudInstancesResponse struct {
+	CreateCloudInstanceResponse
+	Cloud []CreateCloudInstanceResponse `json:"cloud"`
+}
+
+// CreateMultiple deploys one instance per entry of params.Cloud in a single request
+// and returns every created instance.
+func (s *CloudInstancesService) CreateMultiple(params CreateCloudInstanceParams, opts ...CallOption) ([]CreateCloudInstanceResponse, error) {
+	if len(params.Cloud) == 0 {
+		return nil, errors.New("at least one cloud hostname is required")
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	reqUrl := "cloud/deploy"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
+
+	var cloudInstances CreateCloudInstancesResponse
+	resp, err := s.client.Do(req, &cloudInstances)
+	if err != nil {
+		return nil, err
+	}
+	if cloudInstances.Status != "success" && cloudInstances.Status != "" {
+		return nil, newErrorResponse(resp, cloudInstances.Message)
+	}
+	if len(cloudInstances.Cloud) == 0 {
+		return []CreateCloudInstanceResponse{cloudInstances.CreateCloudInstanceResponse}, nil
+	}
+
+	return cloudInstances.Cloud, nil
+}
+
+func (s *CloudInstancesService) Read(instanceId string, opts ...CallOption) (*CloudInstance, error) {
 	reqUrl := "cloud/" + instanceId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var cloudInstances CloudInstances
-	_, err := s.client.Do(req, &cloudInstances)
+	resp, err := s.client.Do(req, &cloudInstances)
 	if err != nil {
 		return nil, err
 	}
 	if cloudInstances.Status != "success" && cloudInstances.Status != "" {
-		return nil, errors.New(cloudInstances.Message)
+		return nil, newErrorResponse(resp, cloudInstances.Message)
 	}
 	if len(cloudInstances.CloudInstance) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("cloud instance %s not found", instanceId)
 	}
 
 	return &cloudInstances.CloudInstance[0], nil
 }
 
-func (s *CloudInstancesService) List() ([]CloudInstance, error) {
-	reqUrl := "cloud"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+// ListFirewalls returns every firewall attached to the instance.
+// An instance can be protected by several firewalls at once; attach each one with
+// FirewallService.AddCloudInsanceToFirewall.
+func (s *CloudInstancesService) ListFirewalls(instanceId string) ([]CloudInstanceFirewalls, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstance.Firewalls, nil
+}
+
+func (s *CloudInstancesService) List(opts ...ListOptions) ([]CloudInstance, error) {
+	cloudInstances, err := s.list(opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstances.CloudInstance, nil
+}
+
+// ListPage returns a single page of instances along with the pagination details
+func (s *CloudInstancesService) ListPage(opts ListOptions) ([]CloudInstance, *Meta, error) {
+	cloudInstances, err := s.list([]ListOptions{opts}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cloudInstances.CloudInstance, &cloudInstances.Meta, nil
+}
+
+// ListAll walks every page and returns all instances.
+// perPage sets the page size, use 0 for the API default.
+func (s *CloudInstancesService) ListAll(perPage int) ([]CloudInstance, error) {
+	var all []CloudInstance
+	for page := 1; ; page++ {
+		cloudInstances, meta, err := s.ListPage(ListOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, cloudInstances...)
+
+		if len(cloudInstances) == 0 || meta.Currentpage >= meta.Totalpages {
+			return all, nil
+		}
+	}
+}
+
+// CloudInstanceListFilter narrows down the instances returned by ListFiltered.
+// Empty fields don't filter.
+type CloudInstanceListFilter struct {
+	DCSlug string
+	Status InstanceStatus
+	Tag    string
+}
+
+// ListFiltered returns the instances matching filter, the filtering is done by the API
+func (s *CloudInstancesService) ListFiltered(filter CloudInstanceListFilter, opts ...ListOptions) ([]CloudInstance, error) {
+	query := url.Values{}
+	if filter.DCSlug != "" {
+		query.Set("dcslug", filter.DCSlug)
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status.String())
+	}
+	if filter.Tag != "" {
+		query.Set("tag", filter.Tag)
+	}
+
+	cloudInstances, err := s.list(opts, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstances.CloudInstance, nil
+}
+
+func (s *CloudInstancesService) list(opts []ListOptions, filter url.Values) (*CloudInstances, error) {
+	reqUrl := addQuery(addListOptions("cloud", opts), filter)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var cloudInstances CloudInstances
-	_, err := s.client.Do(req, &cloudInstances)
+	resp, err := s.client.Do(req, &cloudInstances)
 	if err != nil {
 		return nil, err
 	}
 	if cloudInstances.Status != "success" && cloudInstances.Status != "" {
-		return nil, errors.New(cloudInstances.Message)
+		return nil, newErrorResponse(resp, cloudInstances.Message)
 	}
 
-	return cloudInstances.CloudInstance, nil
+	return &cloudInstances, nil
+}
+
+type CloudInstanceTagsParams struct {
+	Tags []string `json:"tags"`
+}
+
+// AddTags adds tags to an instance, tags it already carries are left as they are
+func (s *CloudInstancesService) AddTags(instanceId string, tags []string) (*BasicResponse, error) {
+	return s.updateTags(instanceId, "add", tags)
+}
+
+// RemoveTags removes tags from an instance
+func (s *CloudInstancesService) RemoveTags(instanceId string, tags []string) (*BasicResponse, error) {
+	return s.updateTags(instanceId, "remove", tags)
+}
+
+func (s *CloudInstancesService) updateTags(instanceId, action string, tags []string) (*BasicResponse, error) {
+	if len(tags) == 0 {
+		return nil, errors.New("at least one tag is required")
+	}
+
+	reqUrl := "cloud/" + instanceId + "/tags/" + action
+	req, err := s.client.NewRequest("POST", reqUrl, &CloudInstanceTagsParams{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+// UpdateCloudInstanceParams holds the fields Update can change.
+// The API has no label on instances, separate from the hostname, so there is no Label to update.
+type UpdateCloudInstanceParams struct {
+	Hostname string `json:"hostname"`
+}
+
+// Update changes the hostname of an instance and returns the updated instance
+func (s *CloudInstancesService) Update(instanceId string, params UpdateCloudInstanceParams) (*CloudInstance, error) {
+	if params.Hostname == "" {
+		return nil, errors.New("hostname can't be empty")
+	}
+
+	reqUrl := "cloud/" + instanceId + "/hostname"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return s.Read(instanceId)
 }
 
 type DeleteCloudInstanceParams struct {
@@ -272,80 +584,220 @@ type DeleteCloudInstanceParams struct {
 	Confirm string `json:"confirm"`
 }
 
-func (s *CloudInstancesService) Delete(cloudInstancesId string, deleteCloudInstanceParams DeleteCloudInstanceParams) (*DeleteResponse, error) {
+func (s *CloudInstancesService) Delete(cloudInstancesId string, deleteCloudInstanceParams DeleteCloudInstanceParams, opts ...CallOption) (*DeleteResponse, error) {
 	reqUrl := "cloud/" + cloudInstancesId + "/destroy"
 
-	req, _ := s.client.NewRequest("DELETE", reqUrl, deleteCloudInstanceParams)
+	req, err := s.client.NewRequest("DELETE", reqUrl, deleteCloudInstanceParams)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
+// deleteCloudInstanceConfirm is the confirmation the API expects when destroying an instance
+const deleteCloudInstanceConfirm = "I am aware this action will delete data and server permanently"
+
+// DeleteMany destroys the instances with the given ids concurrently.
+// Failed deletions are reported together in the returned error, the other instances are still deleted.
+func (s *CloudInstancesService) DeleteMany(ids []string, deleteCloudInstanceParams DeleteCloudInstanceParams) error {
+	if deleteCloudInstanceParams.Confirm != deleteCloudInstanceConfirm {
+		return fmt.Errorf("confirm must be %q", deleteCloudInstanceConfirm)
+	}
+
+	return forEachConcurrently(len(ids), func(i int) error {
+		if _, err := s.Delete(ids[i], deleteCloudInstanceParams); err != nil {
+			return fmt.Errorf("instance %s: %w", ids[i], err)
+		}
+		return nil
+	})
+}
+
 func (s *CloudInstancesService) ListOsImages() ([]OsImage, error) {
 	reqUrl := "cloud/images"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var osImages OsImages
-	_, err := s.client.Do(req, &osImages)
+	resp, err := s.client.Do(req, &osImages)
 	if err != nil {
 		return nil, err
 	}
 	if osImages.Status != "success" && osImages.Status != "" {
-		return nil, errors.New(osImages.Message)
+		return nil, newErrorResponse(resp, osImages.Message)
 	}
 
 	return osImages.OsImages, nil
 }
 
+// ListPlans returns every plan a new instance can be deployed with, along with its pricing.
+// Plan.ID is the value expected by CreateCloudInstanceParams.Planid.
+func (s *CloudInstancesService) ListPlans() ([]Plan, error) {
+	reqUrl := "cloud/plans"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var plans Plans
+	resp, err := s.client.Do(req, &plans)
+	if err != nil {
+		return nil, err
+	}
+	if plans.Status != "success" && plans.Status != "" {
+		return nil, newErrorResponse(resp, plans.Message)
+	}
+
+	return plans.Plans, nil
+}
+
 func (s *CloudInstancesService) ListResizePlans(instanceId string) ([]Plan, error) {
 	reqUrl := "cloud/" + instanceId + "/resizeplans"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var plans Plans
-	_, err := s.client.Do(req, &plans)
+	resp, err := s.client.Do(req, &plans)
 	if err != nil {
 		return nil, err
 	}
 	if plans.Status != "success" && plans.Status != "" {
-		return nil, errors.New(plans.Message)
+		return nil, newErrorResponse(resp, plans.Message)
 	}
 
 	return plans.Plans, nil
 }
 
+// UpgradePlan is a resize plan along with how much its monthly price differs from the current plan
+type UpgradePlan struct {
+	Plan
+	// PriceDelta is Monthly minus the Monthly price of the instance's current plan, rounded to cents
+	PriceDelta float64 `json:"price_delta"`
+}
+
+// ListUpgradePlans returns the resize plans of an instance with their monthly price delta.
+// The price of the current plan is looked up by the instance's Planid in ListPlans.
+func (s *CloudInstancesService) ListUpgradePlans(instanceId string) ([]UpgradePlan, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	allPlans, err := s.ListPlans()
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(allPlans, func(p Plan) bool { return p.ID == cloudInstance.Planid })
+	if i < 0 {
+		return nil, newNotFoundError("plan %s of cloud instance %s not found", cloudInstance.Planid, instanceId)
+	}
+	currentMonthly := allPlans[i].Monthly
+
+	plans, err := s.ListResizePlans(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradePlans := make([]UpgradePlan, 0, len(plans))
+	for _, plan := range plans {
+		upgradePlans = append(upgradePlans, UpgradePlan{
+			Plan:       plan,
+			PriceDelta: math.Round((plan.Monthly-currentMonthly)*100) / 100,
+		})
+	}
+
+	return upgradePlans, nil
+}
+
 func (s *CloudInstancesService) CreateSnapshot(instanceId string) (*CreateBasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/snapshot/create"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot CreateBasicResponse
+	resp, err := s.client.Do(req, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Status != "success" && snapshot.Status != "" {
+		return nil, newErrorResponse(resp, snapshot.Message)
+	}
+
+	return &snapshot, nil
+}
+
+type CreateSnapshotParams struct {
+	Name string `json:"name,omitempty"`
+	// Lock keeps the snapshot from being removed by automatic snapshot rotation
+	Lock bool `json:"lock,omitempty"`
+}
+
+// CreateSnapshotWithParams creates a named and optionally locked snapshot of an instance
+func (s *CloudInstancesService) CreateSnapshotWithParams(instanceId string, params CreateSnapshotParams) (*CreateBasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/snapshot/create"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var snapshot CreateBasicResponse
-	_, err := s.client.Do(req, &snapshot)
+	resp, err := s.client.Do(req, &snapshot)
 	if err != nil {
 		return nil, err
 	}
 	if snapshot.Status != "success" && snapshot.Status != "" {
-		return nil, errors.New(snapshot.Message)
+		return nil, newErrorResponse(resp, snapshot.Message)
 	}
 
 	return &snapshot, nil
 }
 
+// ListSnapshots returns the snapshots taken of an instance
+func (s *CloudInstancesService) ListSnapshots(instanceId string) ([]Snapshot, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(cloudInstance.Snapshots))
+	for _, snapshot := range cloudInstance.Snapshots {
+		snapshots = append(snapshots, Snapshot(snapshot))
+	}
+
+	return snapshots, nil
+}
+
 func (s *CloudInstancesService) DeleteSnapshot(cloudInstanceId, snapshotId string) (*DeleteResponse, error) {
 	reqUrl := "cloud/" + cloudInstanceId + "/snapshot/" + snapshotId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -353,15 +805,18 @@ func (s *CloudInstancesService) DeleteSnapshot(cloudInstanceId, snapshotId strin
 
 func (s *CloudInstancesService) EnableBackup(instanceId string) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/backups/enable"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
@@ -369,84 +824,109 @@ func (s *CloudInstancesService) EnableBackup(instanceId string) (*BasicResponse,
 
 func (s *CloudInstancesService) DisableBackup(instanceId string) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/backups/disable"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
-func (s *CloudInstancesService) HardReboot(instanceId string) (*BasicResponse, error) {
+func (s *CloudInstancesService) HardReboot(instanceId string, opts ...CallOption) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/hardreboot"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
-func (s *CloudInstancesService) PowerCycle(instanceId string) (*BasicResponse, error) {
+func (s *CloudInstancesService) PowerCycle(instanceId string, opts ...CallOption) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/powercycle"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
-func (s *CloudInstancesService) PowerOff(instanceId string) (*BasicResponse, error) {
+func (s *CloudInstancesService) PowerOff(instanceId string, opts ...CallOption) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/poweroff"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
-func (s *CloudInstancesService) PowerOn(instanceId string) (*BasicResponse, error) {
+func (s *CloudInstancesService) PowerOn(instanceId string, opts ...CallOption) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/poweron"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req, opts)
+	defer cancel()
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
+// RebuildCloudInstanceParams holds everything cloud/{id}/rebuild accepts: an image and a confirmation.
+// SSH keys and password authentication can't be set on rebuild, configure them on the fresh server.
 type RebuildCloudInstanceParams struct {
 	Image string `json:"image"`
 	// Please provide confirm string as follow: "I am aware this action will delete data permanently and build a fresh server"
@@ -455,15 +935,18 @@ type RebuildCloudInstanceParams struct {
 
 func (s *CloudInstancesService) Rebuild(instanceId string, rebuildCloudInstanceParams RebuildCloudInstanceParams) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/rebuild"
-	req, _ := s.client.NewRequest("POST", reqUrl, rebuildCloudInstanceParams)
+	req, err := s.client.NewRequest("POST", reqUrl, rebuildCloudInstanceParams)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
@@ -477,15 +960,18 @@ type ResetPasswordResponse struct {
 
 func (s *CloudInstancesService) ResetPassword(instanceId string) (*ResetPasswordResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/resetpassword"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var resetPasswordResponse ResetPasswordResponse
-	_, err := s.client.Do(req, &resetPasswordResponse)
+	resp, err := s.client.Do(req, &resetPasswordResponse)
 	if err != nil {
 		return nil, err
 	}
 	if resetPasswordResponse.Status != "success" && resetPasswordResponse.Status != "" {
-		return nil, errors.New(resetPasswordResponse.Message)
+		return nil, newErrorResponse(resp, resetPasswordResponse.Message)
 	}
 
 	return &resetPasswordResponse, nil
@@ -498,15 +984,54 @@ type ResizeCloudInstanceParams struct {
 
 func (s *CloudInstancesService) Resize(instanceId string, resizeCloudInstanceParams ResizeCloudInstanceParams) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/resize"
-	req, _ := s.client.NewRequest("POST", reqUrl, resizeCloudInstanceParams)
+	req, err := s.client.NewRequest("POST", reqUrl, resizeCloudInstanceParams)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+type MigrateCloudInstanceParams struct {
+	Dcslug string `json:"dcslug"`
+}
+
+// Migrate moves an instance to the datacenter targetDcslug.
+// The move runs in the background, use WaitForStatus to block until the instance is back up.
+func (s *CloudInstancesService) Migrate(instanceId, targetDcslug string) (*BasicResponse, error) {
+	if targetDcslug == "" {
+		return nil, errors.New("target dcslug can't be empty")
+	}
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+	if cloudInstance.Dclocation.Dc == targetDcslug {
+		return nil, fmt.Errorf("cloud instance %s is already in %s", instanceId, targetDcslug)
+	}
+
+	reqUrl := "cloud/" + instanceId + "/migrate"
+	req, err := s.client.NewRequest("POST", reqUrl, &MigrateCloudInstanceParams{Dcslug: targetDcslug})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
@@ -514,16 +1039,275 @@ func (s *CloudInstancesService) Resize(instanceId string, resizeCloudInstancePar
 
 func (s *CloudInstancesService) RestoreSnapshot(instanceId, snapshotId string) (*BasicResponse, error) {
 	reqUrl := "cloud/" + instanceId + "/snapshot/" + snapshotId + "/restore"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+// InstanceStatus is a lifecycle or power state reported for a cloud instance
+type InstanceStatus string
+
+const (
+	InstanceStatusActive     InstanceStatus = "Active"
+	InstanceStatusPending    InstanceStatus = "Pending"
+	InstanceStatusSuspended  InstanceStatus = "Suspended"
+	InstanceStatusTerminated InstanceStatus = "Terminated"
+	InstanceStatusRunning    InstanceStatus = "Running"
+	InstanceStatusStopped    InstanceStatus = "Stopped"
+)
+
+func (s InstanceStatus) String() string {
+	return string(s)
+}
+
+// InstanceStatus returns the lifecycle state of the instance
+func (c CloudInstance) InstanceStatus() InstanceStatus {
+	return InstanceStatus(c.Status)
+}
+
+// PowerStatus returns the power state of the instance
+func (c CloudInstance) PowerStatus() InstanceStatus {
+	return InstanceStatus(c.Powerstatus)
+}
+
+// instanceFailureStatuses are states an instance will not leave on its own
+var instanceFailureStatuses = []InstanceStatus{InstanceStatusSuspended, InstanceStatusTerminated}
+
+// WaitForStatus polls the instance until its status or power status matches one of targets.
+// It stops early when ctx is done, or when the instance reaches a failure state that is not one of targets.
+// Polling is tuned with WithPollInterval and bounded with WithWaitTimeout or a context deadline:
+// running out of time yields an error wrapping context.DeadlineExceeded, while API failures are returned as *ErrorResponse.
+func (s *CloudInstancesService) WaitForStatus(ctx context.Context, instanceId string, targets []InstanceStatus, opts ...WaitOption) (*CloudInstance, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("at least one target status is required")
+	}
+	o, err := newWaitOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	var cloudInstance *CloudInstance
+	err = o.poll(ctx, "cloud instance "+instanceId, func() (bool, error) {
+		var err error
+		if cloudInstance, err = s.Read(instanceId); err != nil {
+			return false, err
+		}
+		if slices.Contains(targets, cloudInstance.InstanceStatus()) || slices.Contains(targets, cloudInstance.PowerStatus()) {
+			return true, nil
+		}
+		if slices.Contains(instanceFailureStatuses, cloudInstance.InstanceStatus()) {
+			return false, fmt.Errorf("cloud instance %s is %s", instanceId, cloudInstance.Status)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstance, nil
+}
+
+// InstanceCredentials are the login details of a newly deployed instance
+type InstanceCredentials struct {
+	IP       string
+	Password string
+}
+
+// Provision deploys a single instance and waits until it is Active.
+// It returns the instance together with its root credentials, which are only available at creation.
+// Polling is tuned with WithPollInterval and bounded with WithWaitTimeout.
+//
+// When the instance was created but the wait fails or times out, the error is returned along with
+// the credentials and a CloudInstance holding the ID and IP of the new instance, so that the caller
+// can keep waiting or delete it.
+func (s *CloudInstancesService) Provision(ctx context.Context, params CreateCloudInstanceParams, opts ...WaitOption) (*CloudInstance, *InstanceCredentials, error) {
+	if len(params.Cloud) > 1 {
+		return nil, nil, errors.New("provision deploys a single instance, use CreateMultiple for several")
+	}
+	// reject bad options before anything is deployed
+	if _, err := newWaitOptions(opts); err != nil {
+		return nil, nil, err
+	}
+
+	created, err := s.Create(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if created.ID == "" {
+		return nil, nil, errors.New("the API did not return the id of the new instance")
+	}
+
+	credentials := &InstanceCredentials{
+		IP:       created.Ipv4,
+		Password: created.Password,
+	}
+
+	cloudInstance, err := s.WaitForStatus(ctx, created.ID, []InstanceStatus{InstanceStatusActive}, opts...)
+	if err != nil {
+		return &CloudInstance{ID: created.ID, IP: created.Ipv4}, credentials, err
+	}
+	if credentials.IP == "" {
+		credentials.IP = cloudInstance.IP
+	}
+
+	return cloudInstance, credentials, nil
+}
+
+// ListBackups returns the automatic backups taken of an instance
+func (s *CloudInstancesService) ListBackups(instanceId string) ([]Backup, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstance.Backups, nil
+}
+
+func (s *CloudInstancesService) RestoreBackup(instanceId, backupId string) (*BasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/backups/" + backupId + "/restore"
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
+
+type ConsoleURL struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+	Status    string `json:"status,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// GetConsoleURL returns a one time URL for the web console of an instance.
+// The URL is short lived, so fetch a new one whenever the console is needed instead of caching it.
+func (s *CloudInstancesService) GetConsoleURL(instanceId string) (*ConsoleURL, error) {
+	reqUrl := "cloud/" + instanceId + "/console"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var consoleURL ConsoleURL
+	resp, err := s.client.Do(req, &consoleURL)
+	if err != nil {
+		return nil, err
+	}
+	if consoleURL.Status != "success" && consoleURL.Status != "" {
+		return nil, newErrorResponse(resp, consoleURL.Message)
+	}
+
+	return &consoleURL, nil
+}
+
+type UpdateReverseDNSParams struct {
+	Rdns string `json:"rdns"`
+}
+
+// SetReverseDNS sets the PTR record of one of the instance's public IPs
+func (s *CloudInstancesService) SetReverseDNS(instanceId, ip, hostname string) (*BasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/updaterdns/" + ip
+	req, err := s.client.NewRequest("POST", reqUrl, UpdateReverseDNSParams{Rdns: hostname})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+// GetReverseDNS returns the PTR record of one of the instance's public IPs
+func (s *CloudInstancesService) GetReverseDNS(instanceId, ip string) (string, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v4 := range cloudInstance.Networks.Public.V4 {
+		if v4.IPAddress == ip {
+			return v4.Rdns, nil
+		}
+	}
+
+	return "", newNotFoundError("ip %s is not attached to cloud instance %s", ip, instanceId)
+}
+
+// ListPublicIPs returns every public IPv4 address attached to an instance
+func (s *CloudInstancesService) ListPublicIPs(instanceId string) ([]V4Public, error) {
+	cloudInstance, err := s.Read(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudInstance.Networks.Public.V4, nil
+}
+
+func (s *CloudInstancesService) AssignPublicIP(instanceId string) (*BasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/publicip/assign"
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+func (s *CloudInstancesService) DeletePublicIP(instanceId, ip string) (*DeleteResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/publicip/" + ip + "/delete"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return &delResponse, nil
+}
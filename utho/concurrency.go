@@ -0,0 +1,34 @@
+package utho
+
+import (
+	"errors"
+	"sync"
+)
+
+// bulkWorkers bounds the number of concurrent requests made by the bulk helpers
+const bulkWorkers = 4
+
+// forEachConcurrently calls fn for every index below n from a bounded pool of workers.
+// Every index is visited even when some fail, the errors are joined in index order.
+func forEachConcurrently(n int, fn func(i int) error) error {
+	errs := make([]error, n)
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(bulkWorkers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
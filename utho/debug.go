@@ -0,0 +1,68 @@
+package utho
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+const redacted = "REDACTED"
+
+// dumpRequest writes the method, URL, headers and body of req to w
+func dumpRequest(w io.Writer, req *http.Request) {
+	fmt.Fprintf(w, "> %s %s\n", req.Method, req.URL)
+	dumpHeader(w, ">", req.Header)
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			dumpBody(w, data)
+		}
+	}
+}
+
+// dumpResponse writes the status, headers and body of resp to w.
+// The body is read in full and replaced so it can still be decoded.
+func dumpResponse(w io.Writer, resp *http.Response) {
+	fmt.Fprintf(w, "< %s\n", resp.Status)
+	dumpHeader(w, "<", resp.Header)
+
+	if resp.Body == nil {
+		return
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		fmt.Fprintf(w, "< reading body: %v\n", err)
+		return
+	}
+	dumpBody(w, data)
+}
+
+func dumpHeader(w io.Writer, prefix string, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			if name == "Authorization" {
+				value = redacted
+			}
+			fmt.Fprintf(w, "%s %s: %s\n", prefix, name, value)
+		}
+	}
+}
+
+func dumpBody(w io.Writer, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", bytes.TrimRight(data, "\n"))
+}
@@ -1,7 +1,9 @@
 package utho
 
 import (
-	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 )
 
 type DomainService service
@@ -17,7 +19,7 @@ type Domain struct {
 	Message        string      `json:"message"`
 	Nspoint        string      `json:"nspoint"`
 	CreatedAt      string      `json:"created_at"`
-	DnsrecordCount string      `json:"dnsrecord_count"`
+	DnsrecordCount FlexInt     `json:"dnsrecord_count"`
 	Records        []DnsRecord `json:"records"`
 }
 type DnsRecord struct {
@@ -27,6 +29,10 @@ type DnsRecord struct {
 	Value    string `json:"value"`
 	TTL      string `json:"ttl"`
 	Priority string `json:"priority"`
+	// Porttype, Port and Wight are only set on SRV records
+	Porttype string `json:"porttype"`
+	Port     string `json:"port"`
+	Wight    string `json:"wight"`
 }
 
 type CreateDomainParams struct {
@@ -35,15 +41,18 @@ type CreateDomainParams struct {
 
 func (s *DomainService) CreateDomain(params CreateDomainParams) (*BasicResponse, error) {
 	reqUrl := "dns/adddomain"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var domain BasicResponse
-	_, err := s.client.Do(req, &domain)
+	resp, err := s.client.Do(req, &domain)
 	if err != nil {
 		return nil, err
 	}
 	if domain.Status != "success" && domain.Status != "" {
-		return nil, errors.New(domain.Message)
+		return nil, newErrorResponse(resp, domain.Message)
 	}
 
 	return &domain, nil
@@ -51,15 +60,21 @@ func (s *DomainService) CreateDomain(params CreateDomainParams) (*BasicResponse,
 
 func (s *DomainService) ReadDomain(domainName string) (*Domain, error) {
 	reqUrl := "dns/" + domainName
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var domain DnsDomains
-	_, err := s.client.Do(req, &domain)
+	resp, err := s.client.Do(req, &domain)
 	if err != nil {
 		return nil, err
 	}
 	if domain.Status != "success" && domain.Status != "" {
-		return nil, errors.New(domain.Message)
+		return nil, newErrorResponse(resp, domain.Message)
+	}
+	if len(domain.Domains) == 0 {
+		return nil, newNotFoundError("domain %s not found", domainName)
 	}
 
 	return &domain.Domains[0], nil
@@ -67,15 +82,18 @@ func (s *DomainService) ReadDomain(domainName string) (*Domain, error) {
 
 func (s *DomainService) ListDomains() ([]Domain, error) {
 	reqUrl := "dns"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var domain DnsDomains
-	_, err := s.client.Do(req, &domain)
+	resp, err := s.client.Do(req, &domain)
 	if err != nil {
 		return nil, err
 	}
 	if domain.Status != "success" && domain.Status != "" {
-		return nil, errors.New(domain.Message)
+		return nil, newErrorResponse(resp, domain.Message)
 	}
 
 	return domain.Domains, nil
@@ -83,21 +101,25 @@ func (s *DomainService) ListDomains() ([]Domain, error) {
 
 func (s *DomainService) DeleteDomain(domainName string) (*DeleteResponse, error) {
 	reqUrl := "dns/" + domainName + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
 type CreateDnsRecordParams struct {
-	Domain   string
+	Domain   string `json:"-"`
 	Type     string `json:"type"`
 	Hostname string `json:"hostname"`
 	Value    string `json:"value"`
@@ -110,15 +132,18 @@ type CreateDnsRecordParams struct {
 
 func (s *DomainService) CreateDnsRecord(params CreateDnsRecordParams) (*CreateResponse, error) {
 	reqUrl := "dns/" + params.Domain + "/record/add"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var dnsRecord CreateResponse
-	_, err := s.client.Do(req, &dnsRecord)
+	resp, err := s.client.Do(req, &dnsRecord)
 	if err != nil {
 		return nil, err
 	}
 	if dnsRecord.Status != "success" && dnsRecord.Status != "" {
-		return nil, errors.New(dnsRecord.Message)
+		return nil, newErrorResponse(resp, dnsRecord.Message)
 	}
 
 	return &dnsRecord, nil
@@ -126,55 +151,203 @@ func (s *DomainService) CreateDnsRecord(params CreateDnsRecordParams) (*CreateRe
 
 func (s *DomainService) ReadDnsRecord(domainName, dnsRecordID string) (*DnsRecord, error) {
 	reqUrl := "dns/" + domainName
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var domain DnsDomains
-	_, err := s.client.Do(req, &domain)
+	resp, err := s.client.Do(req, &domain)
 	if err != nil {
 		return nil, err
 	}
 	if domain.Status != "success" && domain.Status != "" {
-		return nil, errors.New(domain.Message)
+		return nil, newErrorResponse(resp, domain.Message)
+	}
+	if len(domain.Domains) == 0 {
+		return nil, newNotFoundError("domain %s not found", domainName)
 	}
 
-	var record DnsRecord
 	for _, dnsRecord := range domain.Domains[0].Records {
 		if dnsRecord.ID == dnsRecordID {
-			record = dnsRecord
+			return &dnsRecord, nil
 		}
-
 	}
 
-	return &record, nil
+	return nil, newNotFoundError("dns record %s not found", dnsRecordID)
 }
 
-func (s *DomainService) ListDnsRecords(domainName string) ([]DnsRecord, error) {
+// ListRecordsFilter narrows down the records returned by ListDnsRecords.
+// Empty fields don't filter.
+type ListRecordsFilter struct {
+	// Type is the record type, e.g. MX, compared case insensitively
+	Type string
+	// Name is the hostname, either fully qualified or relative to the zone with "@" for the apex
+	Name string
+}
+
+// ListDnsRecords returns the records of domainName, optionally narrowed down by the first filter.
+// The filter is sent to the API and applied again to the response, so that it also holds
+// when the API returns every record.
+func (s *DomainService) ListDnsRecords(domainName string, filters ...ListRecordsFilter) ([]DnsRecord, error) {
 	reqUrl := "dns/" + domainName
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	var filter ListRecordsFilter
+	if len(filters) > 0 {
+		filter = filters[0]
+		query := url.Values{}
+		if filter.Type != "" {
+			query.Set("type", filter.Type)
+		}
+		if filter.Name != "" {
+			query.Set("hostname", filter.Name)
+		}
+		reqUrl = addQuery(reqUrl, query)
+	}
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var domain DnsDomains
-	_, err := s.client.Do(req, &domain)
+	resp, err := s.client.Do(req, &domain)
 	if err != nil {
 		return nil, err
 	}
 	if domain.Status != "success" && domain.Status != "" {
-		return nil, errors.New(domain.Message)
+		return nil, newErrorResponse(resp, domain.Message)
+	}
+	if len(domain.Domains) == 0 {
+		return nil, newNotFoundError("domain %s not found", domainName)
+	}
+	if filter == (ListRecordsFilter{}) {
+		return domain.Domains[0].Records, nil
+	}
+
+	var records []DnsRecord
+	for _, r := range domain.Domains[0].Records {
+		if filter.matches(domainName, r) {
+			records = append(records, r)
+		}
+	}
+
+	return records, nil
+}
+
+func (f ListRecordsFilter) matches(domainName string, r DnsRecord) bool {
+	if f.Type != "" && !strings.EqualFold(f.Type, r.Type) {
+		return false
+	}
+	if f.Name == "" {
+		return true
 	}
 
-	return domain.Domains[0].Records, nil
+	return qualifyHostname(f.Name, domainName) == qualifyHostname(r.Hostname, domainName)
+}
+
+// qualifyHostname returns hostname fully qualified within zone, in lower case
+func qualifyHostname(hostname, zone string) string {
+	hostname = strings.TrimSuffix(strings.ToLower(hostname), ".")
+	zone = strings.ToLower(zone)
+	switch {
+	case hostname == "@" || hostname == zone:
+		return zone
+	case strings.HasSuffix(hostname, "."+zone):
+		return hostname
+	default:
+		return hostname + "." + zone
+	}
+}
+
+type UpdateDnsRecordParams struct {
+	Domain   string `json:"-"`
+	RecordId string `json:"-"`
+	Type     string `json:"type"`
+	Hostname string `json:"hostname"`
+	Value    string `json:"value"`
+	TTL      string `json:"ttl"`
+	Porttype string `json:"porttype"`
+	Port     string `json:"port"`
+	Priority string `json:"priority"`
+	Wight    string `json:"wight"`
+}
+
+func (s *DomainService) UpdateDnsRecord(params UpdateDnsRecordParams) (*UpdateResponse, error) {
+	reqUrl := "dns/" + params.Domain + "/record/" + params.RecordId + "/update"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var dnsRecord UpdateResponse
+	resp, err := s.client.Do(req, &dnsRecord)
+	if err != nil {
+		return nil, err
+	}
+	if dnsRecord.Status != "success" && dnsRecord.Status != "" {
+		return nil, newErrorResponse(resp, dnsRecord.Message)
+	}
+
+	return &dnsRecord, nil
 }
 
 func (s *DomainService) DeleteDnsRecord(domainName, recordId string) (*DeleteResponse, error) {
 	reqUrl := "dns/" + domainName + "/record/" + recordId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
+
+// ImportRecords creates records in domainName concurrently.
+// Failed rows are reported together in the returned error, the others are still created.
+func (s *DomainService) ImportRecords(domainName string, records []CreateDnsRecordParams) error {
+	return forEachConcurrently(len(records), func(i int) error {
+		record := records[i]
+		record.Domain = domainName
+		if _, err := s.CreateDnsRecord(record); err != nil {
+			return fmt.Errorf("record %d (%s %s): %w", i, record.Type, record.Hostname, err)
+		}
+		return nil
+	})
+}
+
+// ExportRecords returns the records of domainName in the shape accepted by ImportRecords
+func (s *DomainService) ExportRecords(domainName string) ([]CreateDnsRecordParams, error) {
+	dnsRecords, err := s.ListDnsRecords(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]CreateDnsRecordParams, 0, len(dnsRecords))
+	for _, r := range dnsRecords {
+		// the API lists fully qualified hostnames but expects them relative to the zone
+		hostname := strings.TrimSuffix(r.Hostname, "."+domainName)
+		if hostname == domainName {
+			hostname = "@"
+		}
+		records = append(records, CreateDnsRecordParams{
+			Domain:   domainName,
+			Type:     r.Type,
+			Hostname: hostname,
+			Value:    r.Value,
+			TTL:      r.TTL,
+			Porttype: r.Porttype,
+			Port:     r.Port,
+			Priority: r.Priority,
+			Wight:    r.Wight,
+		})
+	}
+
+	return records, nil
+}
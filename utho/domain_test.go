@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -198,7 +199,7 @@ func TestDomainService_ReadDnsRecord_happyPath(t *testing.T) {
 	defer teardown()
 
 	domainName := "example.com"
-	dnsRecordID := "12331"
+	dnsRecordID := "25245"
 	expectedResponse := dummyReadDomainRes
 	serverResponse := dummyReadDomainServerRes
 
@@ -208,8 +209,11 @@ func TestDomainService_ReadDnsRecord_happyPath(t *testing.T) {
 		fmt.Fprint(w, serverResponse)
 	})
 
-	var want DnsRecord
-	_ = json.Unmarshal([]byte(expectedResponse), &want)
+	var domain struct {
+		Record DnsRecord `json:"record"`
+	}
+	_ = json.Unmarshal([]byte(expectedResponse), &domain)
+	want := domain.Record
 
 	got, _ := client.Domain().ReadDnsRecord(domainName, dnsRecordID)
 	if !reflect.DeepEqual(*got, want) {
@@ -256,6 +260,38 @@ func TestDomainService_ListDnsRecord_happyPath(t *testing.T) {
 	}
 }
 
+func TestDomainService_ListDnsRecord_filter(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	domainName := "example.com"
+	mux.HandleFunc("/dns/"+domainName, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		assert.Equal(t, "mx", req.URL.Query().Get("type"))
+		assert.Equal(t, "mail", req.URL.Query().Get("hostname"))
+		// the filter is ignored here, so the client side fallback has to apply it
+		fmt.Fprint(w, `{"domains":[{"domain":"example.com","records":[
+			{"id":"1","hostname":"mail.example.com","type":"MX","value":"mx1.example.net"},
+			{"id":"2","hostname":"mail.example.com","type":"A","value":"1.1.1.1"},
+			{"id":"3","hostname":"example.com","type":"MX","value":"mx2.example.net"},
+			{"id":"4","hostname":"mail.example.com.","type":"MX","value":"mx3.example.net"}
+		]}],"status":"success"}`)
+	})
+
+	got, err := client.Domain().ListDnsRecords(domainName, ListRecordsFilter{Type: "mx", Name: "mail"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "4"}, []string{got[0].ID, got[1].ID})
+	assert.Len(t, got, 2)
+}
+
+func TestListRecordsFilter_apex(t *testing.T) {
+	filter := ListRecordsFilter{Name: "@"}
+
+	assert.True(t, filter.matches("example.com", DnsRecord{Hostname: "example.com"}))
+	assert.False(t, filter.matches("example.com", DnsRecord{Hostname: "www.example.com"}))
+}
+
 func TestDomainService_ListDnsRecord_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
@@ -439,3 +475,126 @@ const dummyListDomainServerRes = `[
 		}
 	}
 ]`
+
+func TestDomainService_UpdateDnsRecord_happyPath(t *testing.T) {
+	token := "token"
+	payload := UpdateDnsRecordParams{
+		Domain:   "example.com",
+		RecordId: "53211",
+		Type:     "MX",
+		Hostname: "@",
+		Value:    "mail.example.com",
+		TTL:      "3600",
+		Priority: "10",
+	}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/dns/"+payload.Domain+"/record/"+payload.RecordId+"/update", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.NotContains(t, got, "RecordId")
+		assert.Equal(t, "10", got["priority"])
+
+		fmt.Fprint(w, dummyUpdateResponseJson)
+	})
+
+	got, err := client.Domain().UpdateDnsRecord(payload)
+
+	var want UpdateResponse
+	_ = json.Unmarshal([]byte(dummyUpdateResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestDomainService_UpdateDnsRecord_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Domain().UpdateDnsRecord(UpdateDnsRecordParams{})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestDomainService_ReadDnsRecord_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/dns/example.com", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","domains":[{"domain":"example.com","records":[{"id":"1"}]}]}`)
+	})
+
+	record, err := client.Domain().ReadDnsRecord("example.com", "2")
+	assert.EqualError(t, err, "404 dns record 2 not found")
+	assert.Nil(t, record)
+}
+
+func TestDomainService_ImportRecords_aggregatesErrors(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	var mu sync.Mutex
+	var created []string
+	mux.HandleFunc("/dns/example.com/record/add", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+
+		var got CreateDnsRecordParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		if got.Hostname == "bad" {
+			fmt.Fprint(w, `{"status":"error","message":"invalid value"}`)
+			return
+		}
+
+		mu.Lock()
+		created = append(created, got.Hostname)
+		mu.Unlock()
+		fmt.Fprint(w, dummyCreateResponseJson)
+	})
+
+	records := []CreateDnsRecordParams{
+		{Type: "A", Hostname: "www", Value: "1.1.1.1", TTL: "3600"},
+		{Type: "A", Hostname: "bad", Value: "x", TTL: "3600"},
+		{Type: "TXT", Hostname: "@", Value: "v=spf1 -all", TTL: "3600"},
+	}
+
+	err := client.Domain().ImportRecords("example.com", records)
+	assert.ErrorContains(t, err, "record 1 (A bad)")
+	assert.ErrorContains(t, err, "invalid value")
+	assert.NotContains(t, err.Error(), "record 0")
+	assert.ElementsMatch(t, []string{"www", "@"}, created)
+}
+
+func TestDomainService_ImportRecords_empty(t *testing.T) {
+	client, _ := NewClient("token")
+
+	assert.Nil(t, client.Domain().ImportRecords("example.com", nil))
+}
+
+func TestDomainService_ExportRecords_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/dns/example.com", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","domains":[{"domain":"example.com","records":[
+			{"id":"1","hostname":"example.com","type":"MX","value":"mail.example.com","ttl":"3600","priority":"10"},
+			{"id":"2","hostname":"www.example.com","type":"A","value":"1.1.1.1","ttl":"300","priority":"0"},
+			{"id":"3","hostname":"_sip._tcp.example.com","type":"SRV","value":"sip.example.com","ttl":"3600","porttype":"TCP","port":"5060","priority":"10","wight":"20"}
+		]}]}`)
+	})
+
+	got, err := client.Domain().ExportRecords("example.com")
+
+	want := []CreateDnsRecordParams{
+		{Domain: "example.com", Type: "MX", Hostname: "@", Value: "mail.example.com", TTL: "3600", Priority: "10"},
+		{Domain: "example.com", Type: "A", Hostname: "www", Value: "1.1.1.1", TTL: "300", Priority: "0"},
+		{Domain: "example.com", Type: "SRV", Hostname: "_sip._tcp", Value: "sip.example.com", TTL: "3600", Porttype: "TCP", Port: "5060", Priority: "10", Wight: "20"},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
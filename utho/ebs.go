@@ -0,0 +1,208 @@
+package utho
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type EBService service
+
+type Volumes struct {
+	Ebs     []Volume `json:"ebs"`
+	Status  string   `json:"status"`
+	Message string   `json:"message"`
+}
+
+type Volume struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Size         string        `json:"disk"`
+	DiskType     string        `json:"disk_type"`
+	Iops         string        `json:"iops"`
+	Throughput   string        `json:"throughput"`
+	Dcslug       string        `json:"dcslug"`
+	Dclocation   VpcDclocation `json:"dclocation"`
+	ResourceID   string        `json:"resourceid"`
+	ResourceType string        `json:"resource_type"`
+	Status       string        `json:"status"`
+	CreatedAt    string        `json:"created_at"`
+}
+
+// Attached reports whether the volume is attached to a resource
+func (v Volume) Attached() bool {
+	return v.ResourceID != "" && v.ResourceID != "0"
+}
+
+type CreateVolumeParams struct {
+	Name       string `json:"name"`
+	Dcslug     string `json:"dcslug"`
+	Disk       string `json:"disk"`
+	DiskType   string `json:"disk_type"`
+	Iops       string `json:"iops,omitempty"`
+	Throughput string `json:"throughput,omitempty"`
+}
+
+func (s *EBService) Create(params CreateVolumeParams) (*CreateResponse, error) {
+	reqUrl := "ebs/create"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var volume CreateResponse
+	resp, err := s.client.Do(req, &volume)
+	if err != nil {
+		return nil, err
+	}
+	if volume.Status != "success" && volume.Status != "" {
+		return nil, newErrorResponse(resp, volume.Message)
+	}
+
+	return &volume, nil
+}
+
+func (s *EBService) Read(volumeId string) (*Volume, error) {
+	reqUrl := "ebs/" + volumeId
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes Volumes
+	resp, err := s.client.Do(req, &volumes)
+	if err != nil {
+		return nil, err
+	}
+	if volumes.Status != "success" && volumes.Status != "" {
+		return nil, newErrorResponse(resp, volumes.Message)
+	}
+	if len(volumes.Ebs) == 0 {
+		return nil, newNotFoundError("volume %s not found", volumeId)
+	}
+
+	return &volumes.Ebs[0], nil
+}
+
+func (s *EBService) List() ([]Volume, error) {
+	reqUrl := "ebs"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes Volumes
+	resp, err := s.client.Do(req, &volumes)
+	if err != nil {
+		return nil, err
+	}
+	if volumes.Status != "success" && volumes.Status != "" {
+		return nil, newErrorResponse(resp, volumes.Message)
+	}
+
+	return volumes.Ebs, nil
+}
+
+func (s *EBService) Delete(volumeId string) (*DeleteResponse, error) {
+	reqUrl := "ebs/" + volumeId + "/destroy"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return &delResponse, nil
+}
+
+type AttachVolumeParams struct {
+	ResourceId string `json:"resourceid"`
+	Type       string `json:"type"`
+}
+
+type AttachVolumeResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Device is the path the volume appears at inside the instance, e.g. /dev/vdb
+	Device string `json:"device"`
+}
+
+func (s *EBService) Attach(volumeId, instanceId string) (*AttachVolumeResponse, error) {
+	reqUrl := "ebs/" + volumeId + "/attach"
+	req, err := s.client.NewRequest("POST", reqUrl, AttachVolumeParams{ResourceId: instanceId, Type: "cloud"})
+	if err != nil {
+		return nil, err
+	}
+
+	var attachResponse AttachVolumeResponse
+	resp, err := s.client.Do(req, &attachResponse)
+	if err != nil {
+		return nil, err
+	}
+	if attachResponse.Status != "success" && attachResponse.Status != "" {
+		return nil, newErrorResponse(resp, attachResponse.Message)
+	}
+
+	return &attachResponse, nil
+}
+
+func (s *EBService) Detach(volumeId, instanceId string) (*BasicResponse, error) {
+	reqUrl := "ebs/" + volumeId + "/detach"
+	req, err := s.client.NewRequest("POST", reqUrl, AttachVolumeParams{ResourceId: instanceId, Type: "cloud"})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+type ResizeVolumeParams struct {
+	Disk string `json:"disk"`
+}
+
+// Resize grows a volume to newSizeGB. Volumes cannot be shrunk.
+func (s *EBService) Resize(volumeId string, newSizeGB int) (*BasicResponse, error) {
+	volume, err := s.Read(volumeId)
+	if err != nil {
+		return nil, err
+	}
+	currentSize, err := strconv.Atoi(volume.Size)
+	if err != nil {
+		return nil, fmt.Errorf("parsing size of volume %s: %w", volumeId, err)
+	}
+	if newSizeGB <= currentSize {
+		return nil, fmt.Errorf("volume %s can only grow: new size %d GB must be larger than %d GB", volumeId, newSizeGB, currentSize)
+	}
+
+	reqUrl := "ebs/" + volumeId + "/resize"
+	req, err := s.client.NewRequest("POST", reqUrl, ResizeVolumeParams{Disk: strconv.Itoa(newSizeGB)})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
@@ -0,0 +1,279 @@
+package utho
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEBService_Create_happyPath(t *testing.T) {
+	token := "token"
+	payload := CreateVolumeParams{
+		Name:     "data",
+		Dcslug:   "innoida",
+		Disk:     "50",
+		DiskType: "ssd",
+	}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/ebs/create", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got CreateVolumeParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, payload, got)
+
+		fmt.Fprint(w, dummyCreateResponseJson)
+	})
+
+	got, err := client.Ebs().Create(payload)
+
+	var want CreateResponse
+	_ = json.Unmarshal([]byte(dummyCreateResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestEBService_Create_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Ebs().Create(CreateVolumeParams{})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestEBService_Read_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	volumeId := "1234"
+
+	mux.HandleFunc("/ebs/"+volumeId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyEbsServerRes)
+	})
+
+	got, err := client.Ebs().Read(volumeId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, dummyVolume, *got)
+	assert.True(t, got.Attached())
+}
+
+func TestEBService_Read_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/ebs/1234", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","ebs":[]}`)
+	})
+
+	got, err := client.Ebs().Read("1234")
+	assert.EqualError(t, err, "404 volume 1234 not found")
+	assert.Nil(t, got)
+}
+
+func TestEBService_Read_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	volume, err := client.Ebs().Read("1234")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if volume != nil {
+		t.Errorf("Was not expecting any volume to be returned, instead got %v", volume)
+	}
+}
+
+func TestEBService_List_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/ebs", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyEbsServerRes)
+	})
+
+	got, err := client.Ebs().List()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Volume{dummyVolume}, got)
+}
+
+func TestEBService_List_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	volumes, err := client.Ebs().List()
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if volumes != nil {
+		t.Errorf("Was not expecting any volumes to be returned, instead got %v", volumes)
+	}
+}
+
+func TestEBService_Delete_happyPath(t *testing.T) {
+	token := "token"
+	volumeId := "1234"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/ebs/"+volumeId+"/destroy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, err := client.Ebs().Delete(volumeId)
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestEBService_Delete_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	delResponse, err := client.Ebs().Delete("1234")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
+func TestEBService_Attach_happyPath(t *testing.T) {
+	token := "token"
+	volumeId := "1234"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/ebs/"+volumeId+"/attach", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got AttachVolumeParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, AttachVolumeParams{ResourceId: "1277662", Type: "cloud"}, got)
+
+		fmt.Fprint(w, `{"status":"success","message":"Volume attached","device":"/dev/vdb"}`)
+	})
+
+	got, err := client.Ebs().Attach(volumeId, "1277662")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "/dev/vdb", got.Device)
+}
+
+func TestEBService_Attach_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Ebs().Attach("1234", "1277662")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestEBService_Detach_happyPath(t *testing.T) {
+	token := "token"
+	volumeId := "1234"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/ebs/"+volumeId+"/detach", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	_, err := client.Ebs().Detach(volumeId, "1277662")
+	assert.Nil(t, err)
+}
+
+func TestEBService_Resize_happyPath(t *testing.T) {
+	token := "token"
+	volumeId := "1234"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/ebs/"+volumeId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyEbsServerRes)
+	})
+	mux.HandleFunc("/ebs/"+volumeId+"/resize", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got ResizeVolumeParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, "100", got.Disk)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	_, err := client.Ebs().Resize(volumeId, 100)
+	assert.Nil(t, err)
+}
+
+func TestEBService_Resize_rejectsShrink(t *testing.T) {
+	volumeId := "1234"
+
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/ebs/"+volumeId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyEbsServerRes)
+	})
+	mux.HandleFunc("/ebs/"+volumeId+"/resize", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("resize request should not be sent")
+	})
+
+	got, err := client.Ebs().Resize(volumeId, 20)
+	assert.ErrorContains(t, err, "can only grow")
+	assert.Nil(t, got)
+}
+
+var dummyVolume = Volume{
+	ID:           "1234",
+	Name:         "data",
+	Size:         "50",
+	DiskType:     "ssd",
+	Dcslug:       "innoida",
+	Dclocation:   VpcDclocation{Dccc: "in", Location: "Delhi (Noida)"},
+	ResourceID:   "1277662",
+	ResourceType: "cloud",
+	Status:       "Active",
+	CreatedAt:    "2024-07-01 10:00:00",
+}
+
+const dummyEbsServerRes = `{
+	"status": "success",
+	"ebs": [
+		{
+			"id": "1234",
+			"name": "data",
+			"disk": "50",
+			"disk_type": "ssd",
+			"dcslug": "innoida",
+			"dclocation": {"dccc": "in", "location": "Delhi (Noida)"},
+			"resourceid": "1277662",
+			"resource_type": "cloud",
+			"status": "Active",
+			"created_at": "2024-07-01 10:00:00"
+		}
+	]
+}`
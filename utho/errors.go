@@ -1,13 +1,39 @@
 package utho
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// Sentinel errors for common failures, to be matched with errors.Is.
+// An *ErrorResponse matches them based on its HTTP status code.
+var (
+	// ErrNotFound is returned for 404 responses and when a resource is missing from a lookup
+	ErrNotFound = errors.New("NotFound")
+	// ErrUnauthorized is returned for 401 responses, e.g. for an invalid API token
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited is returned for 429 responses
+	ErrRateLimited = errors.New("rate limited")
+)
+
+var statusSentinels = map[int]error{
+	http.StatusNotFound:        ErrNotFound,
+	http.StatusUnauthorized:    ErrUnauthorized,
+	http.StatusTooManyRequests: ErrRateLimited,
+}
+
+// ErrorResponse is returned whenever the Utho API reports a failure, either through
+// the HTTP status code or through a non successful status in the response body.
+// Use errors.As to inspect StatusCode, Code and Message.
 type ErrorResponse struct {
-	Response *http.Response
-	Errors   []Error `json:"errors"`
+	Response   *http.Response `json:"-"`
+	StatusCode int            `json:"-"`
+	// RequestID identifies the failed request, quote it when contacting Utho support
+	RequestID string  `json:"-"`
+	Code      string  `json:"code"`
+	Message   string  `json:"message"`
+	Errors    []Error `json:"errors"`
 }
 
 type Error struct {
@@ -17,8 +43,64 @@ type Error struct {
 	Meta        interface{} `json:"meta,omitempty"`
 }
 
+// newErrorResponse builds the error for a response whose body reported a failure
+func newErrorResponse(resp *http.Response, message string) *ErrorResponse {
+	return &ErrorResponse{Response: resp, StatusCode: resp.StatusCode, RequestID: requestID(resp), Message: message}
+}
+
+// newNotFoundError reports a resource missing from an otherwise successful response
+// as a 404, the same way the API reports unknown resources
+func newNotFoundError(format string, args ...interface{}) *ErrorResponse {
+	return &ErrorResponse{StatusCode: http.StatusNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// requestIDHeader is the response header carrying the ID the API assigned to a request
+const requestIDHeader = "X-Request-Id"
+
+func requestID(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	return resp.Header.Get(requestIDHeader)
+}
+
 func (e *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %+v",
+	msg := e.message()
+	if e.RequestID != "" {
+		msg += " (request id " + e.RequestID + ")"
+	}
+	return msg
+}
+
+func (e *ErrorResponse) message() string {
+	if e.Response == nil || e.Response.Request == nil {
+		return fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+	}
+
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("%v %v: %d %s",
+			e.Response.Request.Method, e.Response.Request.URL,
+			e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("%v %v: %d %s %+v",
 		e.Response.Request.Method, e.Response.Request.URL,
-		e.Response.StatusCode, e.Errors)
+		e.StatusCode, e.Message, e.Errors)
+}
+
+// Is reports whether target is the sentinel error for the StatusCode of e, such as ErrNotFound,
+// or an *ErrorResponse whose non zero StatusCode and Code match e,
+// so that errors.Is(err, &ErrorResponse{StatusCode: http.StatusNotFound}) works.
+func (e *ErrorResponse) Is(target error) bool {
+	if sentinel, ok := statusSentinels[e.StatusCode]; ok && target == sentinel {
+		return true
+	}
+
+	t, ok := target.(*ErrorResponse)
+	if !ok {
+		return false
+	}
+
+	return (t.StatusCode == 0 || t.StatusCode == e.StatusCode) &&
+		(t.Code == "" || t.Code == e.Code)
 }
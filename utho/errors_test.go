@@ -0,0 +1,185 @@
+package utho
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorResponse_httpStatus(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":"error","message":"Cloud server not found"}`)
+	})
+
+	_, err := client.CloudInstances().Read("someId")
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, http.StatusNotFound, errorResponse.StatusCode)
+	assert.Equal(t, "Cloud server not found", errorResponse.Message)
+	assert.True(t, errors.Is(err, &ErrorResponse{StatusCode: http.StatusNotFound}))
+	assert.False(t, errors.Is(err, &ErrorResponse{StatusCode: http.StatusTooManyRequests}))
+}
+
+func TestErrorResponse_errorsList(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"errors":[{"message":"Too many requests","code":"rate_limited"}]}`)
+	})
+
+	_, err := client.CloudInstances().List()
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, http.StatusTooManyRequests, errorResponse.StatusCode)
+	assert.Equal(t, "rate_limited", errorResponse.Code)
+	assert.Equal(t, "Too many requests", errorResponse.Message)
+	assert.True(t, errors.Is(err, &ErrorResponse{Code: "rate_limited"}))
+}
+
+func TestErrorResponse_bodyStatus(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId/poweron", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Server is already running"}`)
+	})
+
+	_, err := client.CloudInstances().PowerOn("someId")
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, http.StatusOK, errorResponse.StatusCode)
+	assert.Equal(t, "Server is already running", errorResponse.Message)
+	assert.Contains(t, err.Error(), "Server is already running")
+}
+
+func TestErrorResponse_requestID(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Request-Id", "req-7f3a")
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"status":"error","message":"Internal error"}`)
+	})
+	mux.HandleFunc("/cloud/otherId", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Request-Id", "req-9b1c")
+		fmt.Fprint(w, `{"status":"error","message":"Cloud server not found"}`)
+	})
+
+	_, err := client.CloudInstances().Read("someId")
+
+	var errorResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, "req-7f3a", errorResponse.RequestID)
+	assert.Contains(t, err.Error(), "(request id req-7f3a)")
+
+	_, err = client.CloudInstances().Read("otherId")
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, "req-9b1c", errorResponse.RequestID)
+}
+
+func TestErrorResponse_sentinels(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		client, mux, _, teardown := setup("token")
+		mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(tt.statusCode)
+			fmt.Fprint(w, `{"status":"error","message":"failed"}`)
+		})
+
+		_, err := client.CloudInstances().Read("someId")
+		teardown()
+
+		assert.ErrorIs(t, err, tt.want, "status %d", tt.statusCode)
+		for _, other := range []error{ErrNotFound, ErrUnauthorized, ErrRateLimited} {
+			if other != tt.want {
+				assert.NotErrorIs(t, err, other, "status %d", tt.statusCode)
+			}
+		}
+
+		var errorResponse *ErrorResponse
+		assert.True(t, errors.As(err, &errorResponse))
+		assert.Equal(t, tt.statusCode, errorResponse.StatusCode)
+	}
+}
+
+func TestErrorResponse_sentinelsOtherStatus(t *testing.T) {
+	err := &ErrorResponse{StatusCode: http.StatusInternalServerError}
+
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrUnauthorized)
+	assert.NotErrorIs(t, err, ErrRateLimited)
+}
+
+func TestErrNotFound_clientSideLookup(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"cloud":[],"status":"success"}`)
+	})
+
+	_, err := client.CloudInstances().Read("someId")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestErrNotFound_clientSideLookupPerService(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		// every lookup below gets a successful answer that lacks the resource
+		fmt.Fprint(w, `{"status":"success"}`)
+	})
+
+	lookups := map[string]func() error{
+		"Account":        func() error { _, err := client.Account().Read(); return err },
+		"Action":         func() error { _, err := client.Action().Read("1"); return err },
+		"AutoScaling":    func() error { _, err := client.AutoScaling().Read("1"); return err },
+		"CloudInstances": func() error { _, err := client.CloudInstances().Read("1"); return err },
+		"Domain":         func() error { _, err := client.Domain().ReadDnsRecord("example.com", "1"); return err },
+		"Ebs":            func() error { _, err := client.Ebs().Read("1"); return err },
+		"Firewall":       func() error { _, err := client.Firewall().Read("1"); return err },
+		"Kubernetes":     func() error { _, err := client.Kubernetes().Read("1"); return err },
+		"Loadbalancers":  func() error { _, err := client.Loadbalancers().ReadBackend("1", "2"); return err },
+		"Monitoring":     func() error { _, err := client.Monitoring().ReadAlert("1"); return err },
+		"ObjectStorage":  func() error { _, err := client.ObjectStorage().ReadBucket("innoida", "1"); return err },
+		"Sqs":            func() error { _, err := client.Sqs().Read("1"); return err },
+		"Ssl":            func() error { _, err := client.Ssl().Read("1"); return err },
+		"Stacks":         func() error { _, err := client.Stacks().Read("1"); return err },
+		"TargetGroup":    func() error { _, err := client.TargetGroup().Read("1"); return err },
+		"Vpc":            func() error { _, err := client.Vpc().Read("1"); return err },
+	}
+	for service, lookup := range lookups {
+		t.Run(service, func(t *testing.T) {
+			err := lookup()
+
+			var errorResponse *ErrorResponse
+			if assert.True(t, errors.As(err, &errorResponse)) {
+				assert.Equal(t, http.StatusNotFound, errorResponse.StatusCode)
+			}
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
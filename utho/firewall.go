@@ -2,6 +2,8 @@ package utho
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 type FirewallService service
@@ -16,9 +18,15 @@ type Firewall struct {
 	ID           string         `json:"id"`
 	Name         string         `json:"name"`
 	CreatedAt    string         `json:"created_at"`
-	Rulecount    string         `json:"rulecount"`
-	Serverscount string         `json:"serverscount"`
+	Rulecount    FlexInt        `json:"rulecount"`
+	Serverscount FlexInt        `json:"serverscount"`
 	Rules        []FirewallRule `json:"rules"`
+	// Servers lists the cloud instances the firewall is attached to, Serverscount holds their number
+	Servers []FirewallServer `json:"servers"`
+}
+type FirewallServer struct {
+	Cloudid string `json:"cloudid"`
+	Name    string `json:"name"`
 }
 type FirewallRule struct {
 	ID         string `json:"id"`
@@ -41,15 +49,18 @@ type CreateFirewallResponse struct {
 
 func (s *FirewallService) Create(params CreateFirewallParams) (*CreateFirewallResponse, error) {
 	reqUrl := "firewall/create"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewall CreateFirewallResponse
-	_, err := s.client.Do(req, &firewall)
+	resp, err := s.client.Do(req, &firewall)
 	if err != nil {
 		return nil, err
 	}
 	if firewall.Status != "success" && firewall.Status != "" {
-		return nil, errors.New(firewall.Message)
+		return nil, newErrorResponse(resp, firewall.Message)
 	}
 
 	return &firewall, nil
@@ -57,18 +68,21 @@ func (s *FirewallService) Create(params CreateFirewallParams) (*CreateFirewallRe
 
 func (s *FirewallService) Read(firewallId string) (*Firewall, error) {
 	reqUrl := "firewall/" + firewallId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewall Firewalls
-	_, err := s.client.Do(req, &firewall)
+	resp, err := s.client.Do(req, &firewall)
 	if err != nil {
 		return nil, err
 	}
 	if firewall.Status != "success" && firewall.Status != "" {
-		return nil, errors.New(firewall.Message)
+		return nil, newErrorResponse(resp, firewall.Message)
 	}
 	if len(firewall.Firewalls) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("firewall %s not found", firewallId)
 	}
 
 	return &firewall.Firewalls[0], nil
@@ -76,15 +90,18 @@ func (s *FirewallService) Read(firewallId string) (*Firewall, error) {
 
 func (s *FirewallService) List() ([]Firewall, error) {
 	reqUrl := "firewall"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewall Firewalls
-	_, err := s.client.Do(req, &firewall)
+	resp, err := s.client.Do(req, &firewall)
 	if err != nil {
 		return nil, err
 	}
 	if firewall.Status != "success" && firewall.Status != "" {
-		return nil, errors.New(firewall.Message)
+		return nil, newErrorResponse(resp, firewall.Message)
 	}
 
 	return firewall.Firewalls, nil
@@ -92,21 +109,25 @@ func (s *FirewallService) List() ([]Firewall, error) {
 
 func (s *FirewallService) Delete(firewallId string) (*DeleteResponse, error) {
 	reqUrl := "firewall/" + firewallId + "/destroy"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
 type CreateFirewallRuleParams struct {
-	FirewallId string
+	FirewallId string `json:"-"`
 	Type       string `json:"type"`
 	Service    string `json:"service"`
 	Protocol   string `json:"protocol"`
@@ -116,15 +137,18 @@ type CreateFirewallRuleParams struct {
 
 func (s *FirewallService) CreateFirewallRule(params CreateFirewallRuleParams) (*CreateResponse, error) {
 	reqUrl := "firewall/" + params.FirewallId + "/rule/add"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewallRule CreateResponse
-	_, err := s.client.Do(req, &firewallRule)
+	resp, err := s.client.Do(req, &firewallRule)
 	if err != nil {
 		return nil, err
 	}
 	if firewallRule.Status != "success" && firewallRule.Status != "" {
-		return nil, errors.New(firewallRule.Message)
+		return nil, newErrorResponse(resp, firewallRule.Message)
 	}
 
 	return &firewallRule, nil
@@ -132,15 +156,18 @@ func (s *FirewallService) CreateFirewallRule(params CreateFirewallRuleParams) (*
 
 func (s *FirewallService) ReadFirewallRule(firewallId, firewallRuleId string) (*FirewallRule, error) {
 	reqUrl := "firewall/" + firewallId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewall Firewalls
-	_, err := s.client.Do(req, &firewall)
+	resp, err := s.client.Do(req, &firewall)
 	if err != nil {
 		return nil, err
 	}
 	if firewall.Status != "success" && firewall.Status != "" {
-		return nil, errors.New(firewall.Message)
+		return nil, newErrorResponse(resp, firewall.Message)
 	}
 
 	var rule FirewallRule
@@ -150,7 +177,7 @@ func (s *FirewallService) ReadFirewallRule(firewallId, firewallRuleId string) (*
 		}
 	}
 	if len(rule.ID) == 0 {
-		return nil, errors.New("firewall rule not found")
+		return nil, newNotFoundError("firewall rule not found")
 	}
 
 	return &rule, nil
@@ -158,15 +185,18 @@ func (s *FirewallService) ReadFirewallRule(firewallId, firewallRuleId string) (*
 
 func (s *FirewallService) ListFirewallRules(firewallId string) ([]FirewallRule, error) {
 	reqUrl := "firewall/" + firewallId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewall Firewalls
-	_, err := s.client.Do(req, &firewall)
+	resp, err := s.client.Do(req, &firewall)
 	if err != nil {
 		return nil, err
 	}
 	if firewall.Status != "success" && firewall.Status != "" {
-		return nil, errors.New(firewall.Message)
+		return nil, newErrorResponse(resp, firewall.Message)
 	}
 
 	return firewall.Firewalls[0].Rules, nil
@@ -174,19 +204,84 @@ func (s *FirewallService) ListFirewallRules(firewallId string) ([]FirewallRule,
 
 func (s *FirewallService) DeleteFirewallRule(firewallId, firewallRuleId string) (*DeleteResponse, error) {
 	reqUrl := "firewall/" + firewallId + "/rule/" + firewallRuleId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
+// SetRules makes rules the complete ruleset of a firewall and returns the resulting rules.
+// Missing rules are added before extra ones are deleted, so existing protection stays in place
+// while the ruleset changes. If any rule can't be added nothing is deleted.
+// Rules that failed are reported together in the returned error, alongside the rules the firewall ended up with.
+func (s *FirewallService) SetRules(firewallId string, rules []CreateFirewallRuleParams) ([]FirewallRule, error) {
+	firewall, err := s.Read(firewallId)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	existing := firewall.Rules
+	keep := make([]bool, len(existing))
+	for _, rule := range rules {
+		if i := matchingFirewallRule(existing, keep, rule); i >= 0 {
+			keep[i] = true
+			continue
+		}
+
+		rule.FirewallId = firewallId
+		if _, err := s.CreateFirewallRule(rule); err != nil {
+			errs = append(errs, fmt.Errorf("adding rule %s %s %s: %w", rule.Type, rule.Protocol, rule.Port, err))
+		}
+	}
+
+	if len(errs) == 0 {
+		for i, rule := range existing {
+			if keep[i] {
+				continue
+			}
+			if _, err := s.DeleteFirewallRule(firewallId, rule.ID); err != nil {
+				errs = append(errs, fmt.Errorf("deleting rule %s: %w", rule.ID, err))
+			}
+		}
+	}
+
+	firewall, err = s.Read(firewallId)
+	if err != nil {
+		return nil, errors.Join(append(errs, err)...)
+	}
+
+	return firewall.Rules, errors.Join(errs...)
+}
+
+// matchingFirewallRule returns the index of the first rule in existing equal to rule
+// that hasn't been claimed yet, or -1
+func matchingFirewallRule(existing []FirewallRule, claimed []bool, rule CreateFirewallRuleParams) int {
+	for i, r := range existing {
+		if claimed[i] {
+			continue
+		}
+		if strings.EqualFold(r.Type, rule.Type) && strings.EqualFold(r.Service, rule.Service) &&
+			strings.EqualFold(r.Protocol, rule.Protocol) && strings.EqualFold(r.Port, rule.Port) &&
+			r.Addresses == rule.Addresses {
+			return i
+		}
+	}
+
+	return -1
+}
+
 type AddCloudInsanceToFirewallParams struct {
 	FirewallId string
 	Cloudid    string `json:"cloudid"`
@@ -194,15 +289,18 @@ type AddCloudInsanceToFirewallParams struct {
 
 func (s *FirewallService) AddCloudInsanceToFirewall(params AddCloudInsanceToFirewallParams) (*CreateResponse, error) {
 	reqUrl := "firewall/" + params.FirewallId + "/server/add"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var firewallRule CreateResponse
-	_, err := s.client.Do(req, &firewallRule)
+	resp, err := s.client.Do(req, &firewallRule)
 	if err != nil {
 		return nil, err
 	}
 	if firewallRule.Status != "success" && firewallRule.Status != "" {
-		return nil, errors.New(firewallRule.Message)
+		return nil, newErrorResponse(resp, firewallRule.Message)
 	}
 
 	return &firewallRule, nil
@@ -210,15 +308,46 @@ func (s *FirewallService) AddCloudInsanceToFirewall(params AddCloudInsanceToFire
 
 func (s *FirewallService) DeleteCloudInsanceFromFirewall(firewallId, firewallRuleId string) (*DeleteResponse, error) {
 	reqUrl := "firewall/" + firewallId + "/server/" + firewallRuleId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
+
+// ListAttachedInstances returns the cloud instances protected by a firewall.
+// The attached servers are taken from the firewall and read concurrently, in the order the firewall lists them.
+func (s *FirewallService) ListAttachedInstances(firewallId string) ([]CloudInstance, error) {
+	firewall, err := s.Read(firewallId)
+	if err != nil {
+		return nil, err
+	}
+	if len(firewall.Servers) == 0 {
+		return nil, nil
+	}
+
+	attached := make([]CloudInstance, len(firewall.Servers))
+	err = forEachConcurrently(len(firewall.Servers), func(i int) error {
+		cloudInstance, err := (*CloudInstancesService)(s).Read(firewall.Servers[i].Cloudid)
+		if err != nil {
+			return fmt.Errorf("instance %s: %w", firewall.Servers[i].Cloudid, err)
+		}
+		attached[i] = *cloudInstance
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attached, nil
+}
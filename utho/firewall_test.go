@@ -2,9 +2,11 @@ package utho
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -295,6 +297,87 @@ func TestFirewallService_DeleteFirewallRule_invalidServer(t *testing.T) {
 	}
 }
 
+func TestFirewallService_SetRules_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	firewallId := "23432613"
+	var calls []string
+	mux.HandleFunc("/firewall/"+firewallId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		if len(calls) == 0 {
+			fmt.Fprint(w, `{"firewalls":[{"id":"23432613","rules":[
+				{"id":"1","type":"incoming","service":"SSH","protocol":"TCP","port":"22","addresses":"0"},
+				{"id":"2","type":"outgoing","service":"PING","protocol":"ICMP","port":"ICMP","addresses":"0"}
+			]}],"status":"success"}`)
+			return
+		}
+		fmt.Fprint(w, `{"firewalls":[{"id":"23432613","rules":[
+			{"id":"1","type":"incoming","service":"SSH","protocol":"TCP","port":"22","addresses":"0"},
+			{"id":"3","type":"incoming","service":"HTTP","protocol":"TCP","port":"80","addresses":"0"}
+		]}],"status":"success"}`)
+	})
+	mux.HandleFunc("/firewall/"+firewallId+"/rule/add", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		var rule CreateFirewallRuleParams
+		_ = json.NewDecoder(req.Body).Decode(&rule)
+		calls = append(calls, "add "+rule.Port)
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+	mux.HandleFunc("/firewall/"+firewallId+"/rule/2/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "DELETE")
+		calls = append(calls, "delete 2")
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	got, err := client.Firewall().SetRules(firewallId, []CreateFirewallRuleParams{
+		{Type: "incoming", Service: "SSH", Protocol: "tcp", Port: "22", Addresses: "0"},
+		{Type: "incoming", Service: "HTTP", Protocol: "tcp", Port: "80", Addresses: "0"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"add 80", "delete 2"}, calls)
+	assert.Equal(t, []string{"1", "3"}, []string{got[0].ID, got[1].ID})
+}
+
+func TestFirewallService_SetRules_addFails(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	firewallId := "23432613"
+	mux.HandleFunc("/firewall/"+firewallId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"firewalls":[{"id":"23432613","rules":[
+			{"id":"1","type":"incoming","service":"SSH","protocol":"TCP","port":"22","addresses":"0"}
+		]}],"status":"success"}`)
+	})
+	mux.HandleFunc("/firewall/"+firewallId+"/rule/add", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Invalid port"}`)
+	})
+	mux.HandleFunc("/firewall/"+firewallId+"/rule/1/delete", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Was not expecting rules to be deleted after a failed add")
+	})
+
+	got, err := client.Firewall().SetRules(firewallId, []CreateFirewallRuleParams{
+		{Type: "incoming", Service: "HTTP", Protocol: "TCP", Port: "abc", Addresses: "0"},
+	})
+
+	assert.ErrorContains(t, err, "adding rule incoming TCP abc")
+	assert.ErrorContains(t, err, "Invalid port")
+	assert.Len(t, got, 1)
+}
+
+func TestFirewallService_SetRules_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	rules, err := client.Firewall().SetRules("11111", nil)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if rules != nil {
+		t.Errorf("Was not expecting any rules to be returned, instead got %v", rules)
+	}
+}
+
 const dummyCreateFirewallResponseJson = `{
 	"id":"11111",
     "status": "success",
@@ -529,3 +612,82 @@ const dummyReadFirewallRuleRes = `{
 	"note": null
 }
 `
+
+func TestFirewallService_ListAttachedInstances_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/firewall/fw1", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"firewalls":[{"id":"fw1","name":"base","serverscount":"2","servers":[
+			{"cloudid":"1","name":"web-01"},
+			{"cloudid":"3","name":"web-03"}
+		]}],"status":"success"}`)
+	})
+	mux.HandleFunc("/cloud/", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		id := strings.TrimPrefix(req.URL.Path, "/cloud/")
+		fmt.Fprintf(w, `{"cloud":[{"cloudid":"%s","firewalls":[{"id":"fw1","name":"base"}]}],"status":"success"}`, id)
+	})
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Was not expecting every instance to be listed")
+	})
+
+	got, err := client.Firewall().ListAttachedInstances("fw1")
+	if err != nil {
+		t.Errorf("Was not expecting an error, instead got %v", err)
+	}
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "3" {
+		t.Errorf("Was expecting instances 1 and 3 to be returned, instead got %v", got)
+	}
+}
+
+func TestFirewallService_ListAttachedInstances_noServers(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/firewall/fw1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"firewalls":[{"id":"fw1","name":"base","serverscount":"0","servers":[]}],"status":"success"}`)
+	})
+
+	got, err := client.Firewall().ListAttachedInstances("fw1")
+	if err != nil {
+		t.Errorf("Was not expecting an error, instead got %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Was not expecting any instances to be returned, instead got %v", got)
+	}
+}
+
+func TestFirewallService_ListAttachedInstances_missingInstance(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/firewall/fw1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"firewalls":[{"id":"fw1","name":"base","serverscount":"1","servers":[{"cloudid":"9","name":"gone"}]}],"status":"success"}`)
+	})
+	mux.HandleFunc("/cloud/9", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"cloud":[],"status":"success"}`)
+	})
+
+	got, err := client.Firewall().ListAttachedInstances("fw1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Was expecting ErrNotFound, instead got %v", err)
+	}
+	if got != nil {
+		t.Errorf("Was not expecting any instances to be returned, instead got %v", got)
+	}
+}
+
+func TestFirewallService_ListAttachedInstances_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	instances, err := client.Firewall().ListAttachedInstances("fw1")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if instances != nil {
+		t.Errorf("Was not expecting any instances to be returned, instead got %v", instances)
+	}
+}
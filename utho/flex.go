@@ -0,0 +1,71 @@
+package utho
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FlexBool is a bool that the API may send as true, 1 or "1", including quoted variants such as "on" and "yes"
+type FlexBool bool
+
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*b = false
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = FlexBool(v)
+	case float64:
+		*b = v != 0
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "1", "true", "on", "yes":
+			*b = true
+		case "", "0", "false", "off", "no":
+			*b = false
+		default:
+			return fmt.Errorf("cannot unmarshal %q into a bool", v)
+		}
+	default:
+		return fmt.Errorf("cannot unmarshal %s into a bool", data)
+	}
+
+	return nil
+}
+
+// FlexInt is an int that the API may send either as a number or as a quoted number
+type FlexInt int
+
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*i = 0
+		return nil
+	}
+
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = strings.TrimSpace(unquoted)
+		if s == "" {
+			*i = 0
+			return nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal %s into an int", data)
+	}
+	*i = FlexInt(n)
+
+	return nil
+}
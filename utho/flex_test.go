@@ -0,0 +1,63 @@
+package utho
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexBool_UnmarshalJSON(t *testing.T) {
+	tests := map[string]bool{
+		`"1"`:     true,
+		`1`:       true,
+		`true`:    true,
+		`"on"`:    true,
+		`"0"`:     false,
+		`0`:       false,
+		`false`:   false,
+		`""`:      false,
+		`null`:    false,
+		`"false"`: false,
+	}
+
+	for data, want := range tests {
+		var got FlexBool
+		err := json.Unmarshal([]byte(data), &got)
+		assert.Nil(t, err, data)
+		assert.Equal(t, want, bool(got), data)
+	}
+
+	var got FlexBool
+	assert.NotNil(t, json.Unmarshal([]byte(`"maybe"`), &got))
+}
+
+func TestFlexInt_UnmarshalJSON(t *testing.T) {
+	tests := map[string]int{
+		`"5"`:  5,
+		`5`:    5,
+		`"-1"`: -1,
+		`""`:   0,
+		`null`: 0,
+	}
+
+	for data, want := range tests {
+		var got FlexInt
+		err := json.Unmarshal([]byte(data), &got)
+		assert.Nil(t, err, data)
+		assert.Equal(t, want, int(got), data)
+	}
+
+	var got FlexInt
+	assert.NotNil(t, json.Unmarshal([]byte(`"five"`), &got))
+	assert.NotNil(t, json.Unmarshal([]byte(`1.5`), &got))
+}
+
+func TestApiKey_writeFlag(t *testing.T) {
+	var keys []ApiKey
+	err := json.Unmarshal([]byte(`[{"id":"1","write":"1"},{"id":"2","write":0}]`), &keys)
+
+	assert.Nil(t, err)
+	assert.True(t, bool(keys[0].Write))
+	assert.False(t, bool(keys[1].Write))
+}
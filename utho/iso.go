@@ -1,9 +1,5 @@
 package utho
 
-import (
-	"errors"
-)
-
 type ISOService service
 
 type ISOs struct {
@@ -33,15 +29,18 @@ type CreateISOParams struct {
 
 func (s *ISOService) Create(params CreateISOParams) (*CreateResponse, error) {
 	reqUrl := "iso/add"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var iso CreateResponse
-	_, err := s.client.Do(req, &iso)
+	resp, err := s.client.Do(req, &iso)
 	if err != nil {
 		return nil, err
 	}
 	if iso.Status != "success" && iso.Status != "" {
-		return nil, errors.New(iso.Message)
+		return nil, newErrorResponse(resp, iso.Message)
 	}
 
 	return &iso, nil
@@ -49,15 +48,18 @@ func (s *ISOService) Create(params CreateISOParams) (*CreateResponse, error) {
 
 func (s *ISOService) List() ([]ISO, error) {
 	reqUrl := "iso"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var iso ISOs
-	_, err := s.client.Do(req, &iso)
+	resp, err := s.client.Do(req, &iso)
 	if err != nil {
 		return nil, err
 	}
 	if iso.Status != "success" && iso.Status != "" {
-		return nil, errors.New(iso.Message)
+		return nil, newErrorResponse(resp, iso.Message)
 	}
 
 	return iso.ISOs, nil
@@ -65,16 +67,62 @@ func (s *ISOService) List() ([]ISO, error) {
 
 func (s *ISOService) Delete(isoId string) (*DeleteResponse, error) {
 	reqUrl := "iso/" + isoId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	_, err := s.client.Do(req, &delResponse)
+	resp, err := s.client.Do(req, &delResponse)
 	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
+
+type MountISOParams struct {
+	Iso string `json:"iso"`
+}
+
+// Mount attaches an ISO to a cloud instance so it can boot from it
+func (s *ISOService) Mount(instanceId, isoId string) (*BasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/mountiso"
+	req, err := s.client.NewRequest("POST", reqUrl, MountISOParams{Iso: isoId})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+func (s *ISOService) Unmount(instanceId string) (*BasicResponse, error) {
+	reqUrl := "cloud/" + instanceId + "/umountiso"
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
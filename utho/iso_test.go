@@ -150,3 +150,66 @@ const dummyCreateIsoRequestJson = `{
     "url": "https://software.download.prss.microsoft.com/dbazure/Win10_22H2_English_x64v1.iso?t=d7dc55e3-3b50-4d99-a510-32723166ab49&P1=1715194710&P2=601&P3=2&P4=CHCbHgRCO7kWiaI%2blqxfj67KjzaJqo7V4FogqdZ9jikjPtP1QHJGENuQLTXC6FxE3wTPuxFguvHZcmJWGjHiIEyvPptOXi2GTANoggReg%2bABWyFJXQp%2fncY2SHMzz7%2beLEJ7gnTEoY9cu3LnFIr9YcFEwKityfZEJVPzlosk6UbH0sb44W4a54YDjFxyHZmHXvzs13Xq3y7SLoCG7xX9Os8jpcbHv1Q%2bPxLVAnZYBUZgFrqcyW6WzyAuqtGa%2fLLfFs64%2f2TsYDTp9xfHTmcIWIVofMPeO17I1csqq8X2DIHXbSURZNBAP%2b9G%2fAujttaT1LgYCfzJNT93ZLLgA4DumA%3d%3d",
     "name": "dqwd"
 }`
+
+func TestISOService_Mount_happyPath(t *testing.T) {
+	token := "token"
+	instanceId := "1277662"
+	isoId := "ubuntu-22.04.iso"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/"+instanceId+"/mountiso", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got MountISOParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, isoId, got.Iso)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.ISO().Mount(instanceId, isoId)
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestISOService_Mount_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.ISO().Mount("1277662", "ubuntu-22.04.iso")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestISOService_Unmount_happyPath(t *testing.T) {
+	token := "token"
+	instanceId := "1277662"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/"+instanceId+"/umountiso", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	_, err := client.ISO().Unmount(instanceId)
+	assert.Nil(t, err)
+}
+
+func TestISOService_Unmount_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.ISO().Unmount("1277662")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
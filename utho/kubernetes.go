@@ -1,7 +1,14 @@
 package utho
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 type KubernetesService service
@@ -31,7 +38,9 @@ type K8s struct {
 	LoadBalancers  []K8sLoadbalancers  `json:"load_balancers"`
 	TargetGroups   []K8sTargetGroups   `json:"target_groups"`
 	SecurityGroups []K8sSecurityGroups `json:"security_groups"`
+	Nodepools      []K8sNodepool       `json:"nodepools"`
 }
+
 type K8sDclocation struct {
 	Location string `json:"location"`
 	Country  string `json:"country"`
@@ -49,6 +58,45 @@ type K8sTargetGroups struct {
 	Protocol any    `json:"protocol"`
 	Port     string `json:"port"`
 }
+
+// K8sNodepool is a worker pool of a cluster.
+// Count is the requested number of nodes and Workers the nodes deployed so far,
+// see Resizing to know whether a scaling operation is still in progress.
+type K8sNodepool struct {
+	ID       string      `json:"id"`
+	Label    string      `json:"label"`
+	Size     string      `json:"size"`
+	PoolType string      `json:"pool_type"`
+	Count    string      `json:"count"`
+	Status   string      `json:"status"`
+	Workers  []K8sWorker `json:"workers"`
+}
+type K8sWorker struct {
+	ID       string `json:"cloudid"`
+	Hostname string `json:"hostname"`
+	IP       string `json:"ip"`
+	Status   string `json:"status"`
+}
+
+// Resizing reports whether the pool hasn't reached its requested node count yet
+func (p K8sNodepool) Resizing() bool {
+	return strconv.Itoa(len(p.Workers)) != p.Count
+}
+
+// Ready reports whether the pool is not resizing and all its workers are active
+func (p K8sNodepool) Ready() bool {
+	if p.Resizing() {
+		return false
+	}
+	for _, worker := range p.Workers {
+		if !strings.EqualFold(worker.Status, "Active") {
+			return false
+		}
+	}
+
+	return true
+}
+
 type K8sSecurityGroups struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -86,15 +134,18 @@ type CreateKubernetesPoliciesParams struct {
 
 func (s *KubernetesService) Create(params CreateKubernetesParams) (*CreateResponse, error) {
 	reqUrl := "kubernetes/deploy"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes CreateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
@@ -102,15 +153,18 @@ func (s *KubernetesService) Create(params CreateKubernetesParams) (*CreateRespon
 
 func (s *KubernetesService) Read(clusterId string) (*K8s, error) {
 	reqUrl := "kubernetes"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes Kubernetes
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	var k8s K8s
@@ -120,43 +174,101 @@ func (s *KubernetesService) Read(clusterId string) (*K8s, error) {
 		}
 	}
 	if len(k8s.ID) == 0 {
-		return nil, errors.New("kubernetess not found")
+		return nil, newNotFoundError("kubernetess not found")
 	}
 	return &k8s, nil
 }
 
 func (s *KubernetesService) List() ([]K8s, error) {
 	reqUrl := "kubernetes"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes Kubernetes
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return kubernetes.K8s, nil
 }
 
+// Ready reports whether the control plane is active, the cluster has finished installing
+// and every node pool has all its workers up and active
+func (k K8s) Ready() bool {
+	if !strings.EqualFold(k.Status, "Active") || !strings.EqualFold(k.AppStatus, "Active") {
+		return false
+	}
+	for _, pool := range k.Nodepools {
+		if !pool.Ready() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// k8sFailureStatuses are cluster statuses that will never become ready
+var k8sFailureStatuses = []string{"failed", "error", "suspended", "terminated"}
+
+// WaitForReady polls a cluster until it is Ready, e.g. before downloading its kubeconfig,
+// and returns it. It gives up when ctx is done or the cluster fails to provision.
+func (s *KubernetesService) WaitForReady(ctx context.Context, clusterId string, opts ...WaitOption) (*K8s, error) {
+	o, err := newWaitOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	var k8s *K8s
+	err = o.poll(ctx, "kubernetes cluster "+clusterId, func() (bool, error) {
+		var err error
+		if k8s, err = s.Read(clusterId); err != nil {
+			return false, err
+		}
+		if k8s.Ready() {
+			return true, nil
+		}
+		for _, status := range []string{k8s.Status, k8s.AppStatus} {
+			if slices.Contains(k8sFailureStatuses, strings.ToLower(status)) {
+				return false, fmt.Errorf("kubernetes cluster %s is %s", clusterId, status)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return k8s, nil
+}
+
 type DeleteKubernetesParams struct {
-	ClusterId string
+	ClusterId string `json:"-"`
 	// confirm message"I am aware this action will delete data and cluster permanently"
 	Confirm string `json:"confirm"`
 }
 
 func (s *KubernetesService) Delete(params DeleteKubernetesParams) (*DeleteResponse, error) {
 	reqUrl := "kubernetes/" + params.ClusterId + "/destroy"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -169,15 +281,18 @@ type CreateKubernetesLoadbalancerParams struct {
 
 func (s *KubernetesService) CreateLoadbalancer(params CreateKubernetesLoadbalancerParams) (*CreateResponse, error) {
 	reqUrl := "kubernetes/" + params.KubernetesId + "/loadbalancer/" + params.LoadbalancerId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes CreateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
@@ -185,15 +300,18 @@ func (s *KubernetesService) CreateLoadbalancer(params CreateKubernetesLoadbalanc
 
 func (s *KubernetesService) ReadLoadbalancer(kubernetesId, loadbalancerId string) (*K8sLoadbalancers, error) {
 	reqUrl := "kubernetes/" + kubernetesId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 	var loadbalancers K8sLoadbalancers
 	for _, r := range kubernetess.K8s[0].LoadBalancers {
@@ -202,7 +320,7 @@ func (s *KubernetesService) ReadLoadbalancer(kubernetesId, loadbalancerId string
 		}
 	}
 	if len(loadbalancers.ID) == 0 {
-		return nil, errors.New("kubernetess loadbalancer not found")
+		return nil, newNotFoundError("kubernetess loadbalancer not found")
 	}
 
 	return &loadbalancers, nil
@@ -210,15 +328,18 @@ func (s *KubernetesService) ReadLoadbalancer(kubernetesId, loadbalancerId string
 
 func (s *KubernetesService) ListLoadbalancers(kubernetesId string) ([]K8sLoadbalancers, error) {
 	reqUrl := "kubernetes/" + kubernetesId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 
 	return kubernetess.K8s[0].LoadBalancers, nil
@@ -226,14 +347,18 @@ func (s *KubernetesService) ListLoadbalancers(kubernetesId string) ([]K8sLoadbal
 
 func (s *KubernetesService) DeleteLoadbalancer(kubernetesId, kubernetesLoadbalancerId string) (*DeleteResponse, error) {
 	reqUrl := "kubernetes/" + kubernetesId + "/loadbalancerpolicy/" + kubernetesLoadbalancerId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -246,15 +371,18 @@ type CreateKubernetesSecurityGroupParams struct {
 
 func (s *KubernetesService) CreateSecurityGroup(params CreateKubernetesSecurityGroupParams) (*CreateResponse, error) {
 	reqUrl := "kubernetes/" + params.KubernetesId + "/securitygroup/" + params.KubernetesSecurityGroupId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes CreateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
@@ -262,15 +390,18 @@ func (s *KubernetesService) CreateSecurityGroup(params CreateKubernetesSecurityG
 
 func (s *KubernetesService) ReadSecurityGroup(kubernetesId, securitygroupId string) (*K8sSecurityGroups, error) {
 	reqUrl := "kubernetes/" + kubernetesId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 	var securitygroups K8sSecurityGroups
 	for _, r := range kubernetess.K8s[0].SecurityGroups {
@@ -279,7 +410,7 @@ func (s *KubernetesService) ReadSecurityGroup(kubernetesId, securitygroupId stri
 		}
 	}
 	if len(securitygroups.ID) == 0 {
-		return nil, errors.New("kubernetess securitygroup not found")
+		return nil, newNotFoundError("kubernetess securitygroup not found")
 	}
 
 	return &securitygroups, nil
@@ -287,15 +418,18 @@ func (s *KubernetesService) ReadSecurityGroup(kubernetesId, securitygroupId stri
 
 func (s *KubernetesService) ListSecurityGroups(kubernetesId string) ([]K8sSecurityGroups, error) {
 	reqUrl := "kubernetes/" + kubernetesId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 
 	return kubernetess.K8s[0].SecurityGroups, nil
@@ -303,14 +437,18 @@ func (s *KubernetesService) ListSecurityGroups(kubernetesId string) ([]K8sSecuri
 
 func (s *KubernetesService) DeleteSecurityGroup(kuberneteseId, kubernetesSecurityGroupId string) (*DeleteResponse, error) {
 	reqUrl := "kubernetes/" + kuberneteseId + "/securitygroup/" + kubernetesSecurityGroupId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -323,35 +461,41 @@ type CreateKubernetesTargetgroupParams struct {
 
 func (s *KubernetesService) CreateTargetgroup(params CreateKubernetesTargetgroupParams) (*CreateResponse, error) {
 	reqUrl := "kubernetes/" + params.KubernetesId + "/targetgroup/" + params.KubernetesTargetgroupId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes CreateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
 }
 
 func (s *KubernetesService) ReadTargetgroup(kubernetesId, targetgroupId string) (*K8sTargetGroups, error) {
-	reqUrl := "kubernetes/"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	reqUrl := "kubernetes/" + kubernetesId
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 
 	if len(kubernetess.K8s) == 0 {
-		return nil, errors.New("No Cluster Found")
+		return nil, newNotFoundError("No Cluster Found")
 	}
 	var targetgroups K8sTargetGroups
 	var k8 K8s
@@ -366,10 +510,10 @@ func (s *KubernetesService) ReadTargetgroup(kubernetesId, targetgroupId string)
 		}
 	}
 	if k8.ID == "" {
-		return nil, errors.New("kubernetes Cluster not found")
+		return nil, newNotFoundError("kubernetes Cluster not found")
 	}
 	if len(targetgroups.ID) == 0 {
-		return nil, errors.New("kubernetess targetgroup not found")
+		return nil, newNotFoundError("kubernetess targetgroup not found")
 	}
 
 	return &targetgroups, nil
@@ -377,15 +521,18 @@ func (s *KubernetesService) ReadTargetgroup(kubernetesId, targetgroupId string)
 
 func (s *KubernetesService) ListTargetgroups(kubernetesId string) ([]K8sTargetGroups, error) {
 	reqUrl := "kubernetes/" + kubernetesId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetess Kubernetes
-	_, err := s.client.Do(req, &kubernetess)
+	resp, err := s.client.Do(req, &kubernetess)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetess.Status != "success" && kubernetess.Status != "" {
-		return nil, errors.New(kubernetess.Message)
+		return nil, newErrorResponse(resp, kubernetess.Message)
 	}
 
 	return kubernetess.K8s[0].TargetGroups, nil
@@ -393,14 +540,18 @@ func (s *KubernetesService) ListTargetgroups(kubernetesId string) ([]K8sTargetGr
 
 func (s *KubernetesService) DeleteTargetgroup(kuberneteseId, kubernetesTargetgroupId string) (*DeleteResponse, error) {
 	reqUrl := "kubernetes/" + kuberneteseId + "/targetgroup/" + kubernetesTargetgroupId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -408,15 +559,18 @@ func (s *KubernetesService) DeleteTargetgroup(kuberneteseId, kubernetesTargetgro
 
 func (s *KubernetesService) PowerOff(kubernetesId string) (*BasicResponse, error) {
 	reqUrl := "kubernetes/" + kubernetesId + "/stop"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
@@ -424,23 +578,26 @@ func (s *KubernetesService) PowerOff(kubernetesId string) (*BasicResponse, error
 
 func (s *KubernetesService) PowerOn(kubernetesId string) (*BasicResponse, error) {
 	reqUrl := "kubernetes/" + kubernetesId + "/start"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var basicResponse BasicResponse
-	_, err := s.client.Do(req, &basicResponse)
+	resp, err := s.client.Do(req, &basicResponse)
 	if err != nil {
 		return nil, err
 	}
 	if basicResponse.Status != "success" && basicResponse.Status != "" {
-		return nil, errors.New(basicResponse.Message)
+		return nil, newErrorResponse(resp, basicResponse.Message)
 	}
 
 	return &basicResponse, nil
 }
 
 type UpdateKubernetesAutoscaleNodepool struct {
-	KubernetesId string
-	NodeId       string
+	KubernetesId string `json:"-"`
+	NodeId       string `json:"-"`
 	Count        string `json:"count"`
 	Label        string `json:"label"`
 	PoolType     string `json:"pool_type"`
@@ -450,23 +607,26 @@ type UpdateKubernetesAutoscaleNodepool struct {
 
 func (s *KubernetesService) UpdateAutoscaleNodepool(params UpdateKubernetesAutoscaleNodepool) (*UpdateResponse, error) {
 	reqUrl := "kubernetes/" + params.KubernetesId + "/nodepool/" + params.NodeId + "/update"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes UpdateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
 }
 
 type UpdateKubernetesStaticNodepool struct {
-	KubernetesId string
-	NodeId       string
+	KubernetesId string `json:"-"`
+	NodeId       string `json:"-"`
 	Count        string `json:"count"`
 	Label        string `json:"label"`
 	PoolType     string `json:"pool_type"`
@@ -475,16 +635,194 @@ type UpdateKubernetesStaticNodepool struct {
 
 func (s *KubernetesService) UpdateStaticNodepool(params UpdateKubernetesStaticNodepool) (*UpdateResponse, error) {
 	reqUrl := "kubernetes/" + params.KubernetesId + "/nodepool/" + params.NodeId + "/update"
-	req, _ := s.client.NewRequest("POST", reqUrl)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var kubernetes UpdateResponse
-	_, err := s.client.Do(req, &kubernetes)
+	resp, err := s.client.Do(req, &kubernetes)
 	if err != nil {
 		return nil, err
 	}
 	if kubernetes.Status != "success" && kubernetes.Status != "" {
-		return nil, errors.New(kubernetes.Message)
+		return nil, newErrorResponse(resp, kubernetes.Message)
 	}
 
 	return &kubernetes, nil
 }
+
+type AddKubernetesNodepoolsParams struct {
+	Nodepools []CreateNodepoolsParams `json:"nodepools"`
+}
+
+// AddNodepool adds a worker pool to an existing cluster
+func (s *KubernetesService) AddNodepool(kubernetesId string, params CreateNodepoolsParams) (*CreateResponse, error) {
+	reqUrl := "kubernetes/" + kubernetesId + "/nodepool/add"
+	req, err := s.client.NewRequest("POST", reqUrl, AddKubernetesNodepoolsParams{Nodepools: []CreateNodepoolsParams{params}})
+	if err != nil {
+		return nil, err
+	}
+
+	var kubernetes CreateResponse
+	resp, err := s.client.Do(req, &kubernetes)
+	if err != nil {
+		return nil, err
+	}
+	if kubernetes.Status != "success" && kubernetes.Status != "" {
+		return nil, newErrorResponse(resp, kubernetes.Message)
+	}
+
+	return &kubernetes, nil
+}
+
+func (s *KubernetesService) DeleteNodepool(kubernetesId, nodepoolId string) (*DeleteResponse, error) {
+	reqUrl := "kubernetes/" + kubernetesId + "/nodepool/" + nodepoolId + "/delete"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return &delResponse, nil
+}
+
+// ReadNodepool returns a worker pool of a cluster, along with its resize progress
+func (s *KubernetesService) ReadNodepool(kubernetesId, nodepoolId string) (*K8sNodepool, error) {
+	reqUrl := "kubernetes/" + kubernetesId
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var kubernetess Kubernetes
+	resp, err := s.client.Do(req, &kubernetess)
+	if err != nil {
+		return nil, err
+	}
+	if kubernetess.Status != "success" && kubernetess.Status != "" {
+		return nil, newErrorResponse(resp, kubernetess.Message)
+	}
+
+	if len(kubernetess.K8s) == 0 {
+		return nil, newNotFoundError("No Cluster Found")
+	}
+	for _, pool := range kubernetess.K8s[0].Nodepools {
+		if pool.ID == nodepoolId {
+			return &pool, nil
+		}
+	}
+
+	return nil, newNotFoundError("kubernetess nodepool not found")
+}
+
+// UpdateNodeCount scales a worker pool to count nodes.
+// The update endpoint replaces the whole pool, so its label, type and size are read first and sent back unchanged.
+// Scaling is asynchronous: the pool keeps resizing after the call returns, see ReadNodepool.
+func (s *KubernetesService) UpdateNodeCount(kubernetesId, nodepoolId string, count int) (*UpdateResponse, error) {
+	if count < 1 {
+		return nil, errors.New("node count must be at least 1")
+	}
+
+	pool, err := s.ReadNodepool(kubernetesId, nodepoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.UpdateStaticNodepool(UpdateKubernetesStaticNodepool{
+		KubernetesId: kubernetesId,
+		NodeId:       nodepoolId,
+		Count:        strconv.Itoa(count),
+		Label:        pool.Label,
+		PoolType:     pool.PoolType,
+		Size:         pool.Size,
+	})
+}
+
+// GetKubeconfig returns the raw kubeconfig YAML of a cluster
+func (s *KubernetesService) GetKubeconfig(kubernetesId string) ([]byte, error) {
+	reqUrl := "kubernetes/" + kubernetesId + "/download"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var kubeconfig bytes.Buffer
+	resp, err := s.client.Do(req, &kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// a cluster that is still being provisioned answers with a JSON status instead of YAML
+	var basicResponse BasicResponse
+	if json.Unmarshal(kubeconfig.Bytes(), &basicResponse) == nil && basicResponse.Status != "success" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+	if kubeconfig.Len() == 0 {
+		return nil, newErrorResponse(resp, "empty kubeconfig")
+	}
+
+	return kubeconfig.Bytes(), nil
+}
+
+type UpgradeKubernetesParams struct {
+	Version string `json:"version"`
+}
+
+// Upgrade moves the control plane of a cluster to the given Kubernetes version
+func (s *KubernetesService) Upgrade(kubernetesId, version string) (*BasicResponse, error) {
+	if version == "" {
+		return nil, errors.New("version is required")
+	}
+
+	reqUrl := "kubernetes/" + kubernetesId + "/upgrade"
+	req, err := s.client.NewRequest("POST", reqUrl, UpgradeKubernetesParams{Version: version})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+type KubernetesUpgradeVersions struct {
+	Versions []string `json:"versions"`
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+}
+
+// ListUpgradeVersions returns the versions a cluster can be upgraded to
+func (s *KubernetesService) ListUpgradeVersions(kubernetesId string) ([]string, error) {
+	reqUrl := "kubernetes/" + kubernetesId + "/upgrade"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var upgradeVersions KubernetesUpgradeVersions
+	resp, err := s.client.Do(req, &upgradeVersions)
+	if err != nil {
+		return nil, err
+	}
+	if upgradeVersions.Status != "success" && upgradeVersions.Status != "" {
+		return nil, newErrorResponse(resp, upgradeVersions.Message)
+	}
+
+	return upgradeVersions.Versions, nil
+}
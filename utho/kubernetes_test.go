@@ -1,11 +1,13 @@
 package utho
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -78,6 +80,86 @@ func TestKubernetesService_Read_happyPath(t *testing.T) {
 	}
 }
 
+func TestKubernetesService_WaitForReady_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/kubernetes", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		polls++
+		appStatus := "Pending"
+		if polls == 3 {
+			appStatus = "Active"
+		}
+		fmt.Fprintf(w, `{"k8s":[{"id":"11111","status":"Active","app_status":%q}],"status":"success"}`, appStatus)
+	})
+
+	got, err := client.Kubernetes().WaitForReady(context.Background(), "11111", WithPollInterval(time.Millisecond))
+
+	assert.Nil(t, err)
+	assert.True(t, got.Ready())
+	assert.Equal(t, 3, polls)
+}
+
+func TestKubernetesService_WaitForReady_nodepoolResizing(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/kubernetes", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		workers := `{"cloudid":"33333","status":"Active"}`
+		switch polls {
+		case 2:
+			workers += `,{"cloudid":"33334","status":"Installing"}`
+		case 3:
+			workers += `,{"cloudid":"33334","status":"Active"}`
+		}
+		fmt.Fprintf(w, `{"k8s":[{"id":"11111","status":"Active","app_status":"Active","nodepools":[
+			{"id":"22222","count":"1","workers":[{"cloudid":"44444","status":"Active"}]},
+			{"id":"22223","count":"2","workers":[%s]}
+		]}],"status":"success"}`, workers)
+	})
+
+	got, err := client.Kubernetes().WaitForReady(context.Background(), "11111", WithPollInterval(time.Millisecond))
+
+	assert.Nil(t, err)
+	assert.True(t, got.Ready())
+	assert.Equal(t, 3, polls)
+}
+
+func TestKubernetesService_WaitForReady_failed(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"k8s":[{"id":"11111","status":"Active","app_status":"Failed"}],"status":"success"}`)
+	})
+
+	got, err := client.Kubernetes().WaitForReady(context.Background(), "11111", WithPollInterval(time.Millisecond))
+
+	assert.EqualError(t, err, "kubernetes cluster 11111 is Failed")
+	assert.Nil(t, got)
+}
+
+func TestKubernetesService_WaitForReady_contextDone(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"k8s":[{"id":"11111","status":"Pending","app_status":"Pending"}],"status":"success"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	got, err := client.Kubernetes().WaitForReady(ctx, "11111", WithPollInterval(5*time.Millisecond))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, got)
+}
+
 func TestKubernetesService_Read_invalidServer(t *testing.T) {
 	client, _ := NewClient("token")
 
@@ -141,6 +223,13 @@ func TestKubernetesService_Delete_happyPath(t *testing.T) {
 	mux.HandleFunc("/kubernetes/"+payload.ClusterId+"/destroy", func(w http.ResponseWriter, req *http.Request) {
 		testHttpMethod(t, req, "DELETE")
 		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got DeleteKubernetesParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		if got.Confirm != payload.Confirm {
+			t.Errorf("Confirm = %q, want %q", got.Confirm, payload.Confirm)
+		}
+
 		fmt.Fprint(w, dummyDeleteResponseJson)
 	})
 
@@ -660,3 +749,279 @@ func TestKubernetesService_PowerOff_invalidServer(t *testing.T) {
 		t.Errorf("Expected error to be returned")
 	}
 }
+
+func TestKubernetesService_UpdateStaticNodepool_sendsParams(t *testing.T) {
+	token := "token"
+	params := UpdateKubernetesStaticNodepool{KubernetesId: "11111", NodeId: "22222", Count: "3"}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+params.KubernetesId+"/nodepool/"+params.NodeId+"/update", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, "3", got["count"])
+
+		fmt.Fprint(w, dummyUpdateResponseJson)
+	})
+
+	_, err := client.Kubernetes().UpdateStaticNodepool(params)
+	assert.Nil(t, err)
+}
+
+func TestKubernetesService_AddNodepool_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+	pool := CreateNodepoolsParams{Label: "workers", Size: "10045", Count: "2"}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/nodepool/add", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got AddKubernetesNodepoolsParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, []CreateNodepoolsParams{pool}, got.Nodepools)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.Kubernetes().AddNodepool(kubernetesId, pool)
+
+	var want CreateResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestKubernetesService_DeleteNodepool_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+	nodepoolId := "22222"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/nodepool/"+nodepoolId+"/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, err := client.Kubernetes().DeleteNodepool(kubernetesId, nodepoolId)
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestKubernetesService_ReadNodepool_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	kubernetesId := "11111"
+	mux.HandleFunc("/kubernetes/"+kubernetesId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyKubernetesNodepoolServerRes)
+	})
+
+	got, err := client.Kubernetes().ReadNodepool(kubernetesId, "22222")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "workers", got.Label)
+	assert.Len(t, got.Workers, 2)
+	assert.True(t, got.Resizing())
+
+	_, err = client.Kubernetes().ReadNodepool(kubernetesId, "gone")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestKubernetesService_ReadNodepool_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	nodepool, err := client.Kubernetes().ReadNodepool("11111", "22222")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if nodepool != nil {
+		t.Errorf("Was not expecting any nodepool to be returned, instead got %v", nodepool)
+	}
+}
+
+func TestKubernetesService_UpdateNodeCount_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+	nodepoolId := "22222"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		fmt.Fprint(w, dummyKubernetesNodepoolServerRes)
+	})
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/nodepool/"+nodepoolId+"/update", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got UpdateKubernetesStaticNodepool
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, UpdateKubernetesStaticNodepool{Count: "5", Label: "workers", PoolType: "static", Size: "10045"}, got)
+
+		fmt.Fprint(w, dummyUpdateResponseJson)
+	})
+
+	_, err := client.Kubernetes().UpdateNodeCount(kubernetesId, nodepoolId, 5)
+	assert.Nil(t, err)
+}
+
+func TestKubernetesService_UpdateNodeCount_unknownNodepool(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/11111", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyKubernetesNodepoolServerRes)
+	})
+	mux.HandleFunc("/kubernetes/11111/nodepool/gone/update", func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("Was not expecting the nodepool to be updated")
+	})
+
+	got, err := client.Kubernetes().UpdateNodeCount("11111", "gone", 5)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, got)
+}
+
+func TestKubernetesService_UpdateNodeCount_invalidCount(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Kubernetes().UpdateNodeCount("11111", "22222", 0)
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestKubernetesService_GetKubeconfig_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+	kubeconfig := "apiVersion: v1\nkind: Config\nclusters: []\n"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/download", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, kubeconfig)
+	})
+
+	got, err := client.Kubernetes().GetKubeconfig(kubernetesId)
+	assert.Nil(t, err)
+	assert.Equal(t, kubeconfig, string(got))
+}
+
+func TestKubernetesService_GetKubeconfig_notReady(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/download", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Cluster is not ready yet"}`)
+	})
+
+	got, err := client.Kubernetes().GetKubeconfig(kubernetesId)
+	assert.Nil(t, got)
+	assert.ErrorContains(t, err, "Cluster is not ready yet")
+}
+
+func TestKubernetesService_GetKubeconfig_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Kubernetes().GetKubeconfig("11111")
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestKubernetesService_Upgrade_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/upgrade", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got UpgradeKubernetesParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, "1.29.1", got.Version)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.Kubernetes().Upgrade(kubernetesId, "1.29.1")
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestKubernetesService_Upgrade_rejected(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/upgrade", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Upgrade to 1.20.0 is not allowed"}`)
+	})
+
+	got, err := client.Kubernetes().Upgrade(kubernetesId, "1.20.0")
+	assert.Nil(t, got)
+	assert.ErrorContains(t, err, "not allowed")
+}
+
+func TestKubernetesService_Upgrade_emptyVersion(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Kubernetes().Upgrade("11111", "")
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestKubernetesService_ListUpgradeVersions_happyPath(t *testing.T) {
+	token := "token"
+	kubernetesId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/kubernetes/"+kubernetesId+"/upgrade", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, `{"status":"success","versions":["1.28.6","1.29.1"]}`)
+	})
+
+	got, err := client.Kubernetes().ListUpgradeVersions(kubernetesId)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1.28.6", "1.29.1"}, got)
+}
+
+func TestKubernetesService_ListUpgradeVersions_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Kubernetes().ListUpgradeVersions("11111")
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
@@ -74,3 +74,23 @@ const dummyReadKubernetesTargetgroupRes = `{
 }`
 
 const dummyListKubernetesTargetgroupRes = `[` + dummyReadKubernetesTargetgroupRes + `]`
+
+const dummyKubernetesNodepoolServerRes = `{
+    "k8s": [{
+        "id": "11111",
+        "nodepools": [
+            {
+                "id": "22222",
+                "label": "workers",
+                "size": "10045",
+                "pool_type": "static",
+                "count": "3",
+                "workers": [
+                    {"cloudid": "33333", "hostname": "workers-1", "ip": "103.11.11.12", "status": "Active"},
+                    {"cloudid": "33334", "hostname": "workers-2", "ip": "103.11.11.13", "status": "Active"}
+                ]
+            }
+        ]
+    }],
+    "status": "success"
+}`
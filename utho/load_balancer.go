@@ -113,15 +113,18 @@ type CreateLoadbalancerResponse struct {
 
 func (s *LoadbalancersService) Create(params CreateLoadbalancerParams) (*CreateLoadbalancerResponse, error) {
 	reqUrl := "loadbalancer"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer CreateLoadbalancerResponse
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
 	}
 
 	return &loadbalancer, nil
@@ -129,18 +132,21 @@ func (s *LoadbalancersService) Create(params CreateLoadbalancerParams) (*CreateL
 
 func (s *LoadbalancersService) Read(loadbalancerId string) (*Loadbalancer, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
 	}
 	if len(loadbalancer.Loadbalancers) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
 	return &loadbalancer.Loadbalancers[0], nil
@@ -148,15 +154,18 @@ func (s *LoadbalancersService) Read(loadbalancerId string) (*Loadbalancer, error
 
 func (s *LoadbalancersService) List() ([]Loadbalancer, error) {
 	reqUrl := "loadbalancer"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
 	}
 
 	return loadbalancer.Loadbalancers, nil
@@ -164,14 +173,18 @@ func (s *LoadbalancersService) List() ([]Loadbalancer, error) {
 
 func (s *LoadbalancersService) Delete(loadbalancerId string) (*DeleteResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -187,15 +200,18 @@ type CreateLoadbalancerACLParams struct {
 
 func (s *LoadbalancersService) CreateACL(params CreateLoadbalancerACLParams) (*CreateResponse, error) {
 	reqUrl := "loadbalancer/" + params.LoadbalancerId + "/acl"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancerACL CreateResponse
-	_, err := s.client.Do(req, &loadbalancerACL)
+	resp, err := s.client.Do(req, &loadbalancerACL)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancerACL.Status != "success" && loadbalancerACL.Status != "" {
-		return nil, errors.New(loadbalancerACL.Message)
+		return nil, newErrorResponse(resp, loadbalancerACL.Message)
 	}
 
 	return &loadbalancerACL, nil
@@ -203,38 +219,49 @@ func (s *LoadbalancersService) CreateACL(params CreateLoadbalancerACLParams) (*C
 
 func (s *LoadbalancersService) ReadACL(loadbalancerId, loadbalancerACLId string) (*ACLs, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
-	var acl ACLs
 	for _, v := range loadbalancer.Loadbalancers[0].Acls {
 		if v.ID == loadbalancerACLId {
-			acl = v
+			return &v, nil
 		}
 	}
 
-	return &acl, nil
+	return nil, newNotFoundError("loadbalancer acl %s not found", loadbalancerACLId)
 }
 
 func (s *LoadbalancersService) ListACLs(loadbalancerId string) ([]ACLs, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
 	return loadbalancer.Loadbalancers[0].Acls, nil
@@ -242,14 +269,18 @@ func (s *LoadbalancersService) ListACLs(loadbalancerId string) ([]ACLs, error) {
 
 func (s *LoadbalancersService) DeleteACL(loadbalancerId, loadbalancerACLId string) (*DeleteResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId + "/acl/" + loadbalancerACLId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -268,15 +299,18 @@ type CreateLoadbalancerFrontendParams struct {
 
 func (s *LoadbalancersService) CreateFrontend(params CreateLoadbalancerFrontendParams) (*CreateResponse, error) {
 	reqUrl := "loadbalancer/" + params.LoadbalancerId + "/frontend"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancerFrontend CreateResponse
-	_, err := s.client.Do(req, &loadbalancerFrontend)
+	resp, err := s.client.Do(req, &loadbalancerFrontend)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancerFrontend.Status != "success" && loadbalancerFrontend.Status != "" {
-		return nil, errors.New(loadbalancerFrontend.Message)
+		return nil, newErrorResponse(resp, loadbalancerFrontend.Message)
 	}
 
 	return &loadbalancerFrontend, nil
@@ -295,53 +329,68 @@ type UpdateLoadbalancerFrontendParams struct {
 
 func (s *LoadbalancersService) UpdateFrontend(params UpdateLoadbalancerFrontendParams, loadbalancerId, loadbalancerFrontendId string) (*UpdateResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId + "/frontend/" + loadbalancerFrontendId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var frontend UpdateResponse
-	if _, err := s.client.Do(req, &frontend); err != nil {
+	resp, err := s.client.Do(req, &frontend)
+	if err != nil {
 		return nil, err
 	}
 
 	if frontend.Status != "success" && frontend.Status != "" {
-		return nil, errors.New(frontend.Message)
+		return nil, newErrorResponse(resp, frontend.Message)
 	}
 	return &frontend, nil
 }
 
 func (s *LoadbalancersService) ReadFrontend(loadbalancerId, loadbalancerFrontendId string) (*Frontends, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
-	var frontend Frontends
 	for _, v := range loadbalancer.Loadbalancers[0].Frontends {
 		if v.ID == loadbalancerFrontendId {
-			frontend = v
+			return &v, nil
 		}
 	}
 
-	return &frontend, nil
+	return nil, newNotFoundError("loadbalancer frontend %s not found", loadbalancerFrontendId)
 }
 
 func (s *LoadbalancersService) ListFrontends(loadbalancerId string) ([]Frontends, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
 	return loadbalancer.Loadbalancers[0].Frontends, nil
@@ -349,14 +398,67 @@ func (s *LoadbalancersService) ListFrontends(loadbalancerId string) ([]Frontends
 
 func (s *LoadbalancersService) DeleteFrontend(loadbalancerId, loadbalancerFrontendId string) (*DeleteResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId + "/frontend/" + loadbalancerFrontendId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return &delResponse, nil
+}
+
+type AttachLoadbalancerCertificateParams struct {
+	CertificateID string `json:"certificate_id"`
+}
+
+// AttachCertificate terminates HTTPS on a frontend with an SSL certificate uploaded through SslService.
+// The API rejects certificates that don't cover the domain of the frontend, that error is returned as is.
+func (s *LoadbalancersService) AttachCertificate(loadbalancerId, loadbalancerFrontendId, certificateId string) (*BasicResponse, error) {
+	if certificateId == "" {
+		return nil, errors.New("certificate id can't be empty")
+	}
+
+	reqUrl := "loadbalancer/" + loadbalancerId + "/frontend/" + loadbalancerFrontendId + "/certificate"
+	req, err := s.client.NewRequest("POST", reqUrl, &AttachLoadbalancerCertificateParams{CertificateID: certificateId})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+// DetachCertificate removes the SSL certificate from a frontend
+func (s *LoadbalancersService) DetachCertificate(loadbalancerId, loadbalancerFrontendId string) (*DeleteResponse, error) {
+	reqUrl := "loadbalancer/" + loadbalancerId + "/frontend/" + loadbalancerFrontendId + "/certificate"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -371,15 +473,18 @@ type CreateLoadbalancerBackendParams struct {
 
 func (s *LoadbalancersService) CreateBackend(params CreateLoadbalancerBackendParams) (*CreateResponse, error) {
 	reqUrl := "loadbalancer/" + params.LoadbalancerId + "/backend"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancerBackend CreateResponse
-	_, err := s.client.Do(req, &loadbalancerBackend)
+	resp, err := s.client.Do(req, &loadbalancerBackend)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancerBackend.Status != "success" && loadbalancerBackend.Status != "" {
-		return nil, errors.New(loadbalancerBackend.Message)
+		return nil, newErrorResponse(resp, loadbalancerBackend.Message)
 	}
 
 	return &loadbalancerBackend, nil
@@ -387,38 +492,49 @@ func (s *LoadbalancersService) CreateBackend(params CreateLoadbalancerBackendPar
 
 func (s *LoadbalancersService) ReadBackend(loadbalancerId, loadbalancerBackendId string) (*Backends, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
-	var backend Backends
 	for _, v := range loadbalancer.Loadbalancers[0].Backends {
 		if v.ID == loadbalancerBackendId {
-			backend = v
+			return &v, nil
 		}
 	}
 
-	return &backend, nil
+	return nil, newNotFoundError("loadbalancer backend %s not found", loadbalancerBackendId)
 }
 
 func (s *LoadbalancersService) ListBackends(loadbalancerId string) ([]Backends, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
 	return loadbalancer.Loadbalancers[0].Backends, nil
@@ -426,14 +542,18 @@ func (s *LoadbalancersService) ListBackends(loadbalancerId string) ([]Backends,
 
 func (s *LoadbalancersService) DeleteBackend(loadbalancerId, loadbalancerBackendId string) (*DeleteResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId + "/backend/" + loadbalancerBackendId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -449,15 +569,18 @@ type CreateLoadbalancerRouteParams struct {
 
 func (s *LoadbalancersService) CreateRoute(params CreateLoadbalancerRouteParams) (*CreateResponse, error) {
 	reqUrl := "loadbalancer/" + params.LoadbalancerId + "/route"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancerRoute CreateResponse
-	_, err := s.client.Do(req, &loadbalancerRoute)
+	resp, err := s.client.Do(req, &loadbalancerRoute)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancerRoute.Status != "success" && loadbalancerRoute.Status != "" {
-		return nil, errors.New(loadbalancerRoute.Message)
+		return nil, newErrorResponse(resp, loadbalancerRoute.Message)
 	}
 
 	return &loadbalancerRoute, nil
@@ -465,38 +588,49 @@ func (s *LoadbalancersService) CreateRoute(params CreateLoadbalancerRouteParams)
 
 func (s *LoadbalancersService) ReadRoute(loadbalancerId, loadbalancerRouteId string) (*Routes, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
-	var backend Routes
 	for _, v := range loadbalancer.Loadbalancers[0].Routes {
 		if v.ID == loadbalancerRouteId {
-			backend = v
+			return &v, nil
 		}
 	}
 
-	return &backend, nil
+	return nil, newNotFoundError("loadbalancer route %s not found", loadbalancerRouteId)
 }
 
 func (s *LoadbalancersService) ListRoutes(loadbalancerId string) ([]Routes, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var loadbalancer Loadbalancers
-	_, err := s.client.Do(req, &loadbalancer)
+	resp, err := s.client.Do(req, &loadbalancer)
 	if err != nil {
 		return nil, err
 	}
 	if loadbalancer.Status != "success" && loadbalancer.Status != "" {
-		return nil, errors.New(loadbalancer.Message)
+		return nil, newErrorResponse(resp, loadbalancer.Message)
+	}
+	if len(loadbalancer.Loadbalancers) == 0 {
+		return nil, newNotFoundError("loadbalancer %s not found", loadbalancerId)
 	}
 
 	return loadbalancer.Loadbalancers[0].Routes, nil
@@ -504,15 +638,76 @@ func (s *LoadbalancersService) ListRoutes(loadbalancerId string) ([]Routes, erro
 
 func (s *LoadbalancersService) DeleteRoute(loadbalancerId, loadbalancerRouteId string) (*DeleteResponse, error) {
 	reqUrl := "loadbalancer/" + loadbalancerId + "/route/" + loadbalancerRouteId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
+
+type CreateLoadbalancerRuleParams struct {
+	LoadbalancerId string `json:"-"`
+	SrcProto       string `json:"src_proto"`
+	SrcPort        string `json:"src_port"`
+	DstProto       string `json:"dst_proto"`
+	DstPort        string `json:"dst_port"`
+}
+
+// AddRule creates a forwarding rule and returns the updated rule list of the load balancer
+func (s *LoadbalancersService) AddRule(params CreateLoadbalancerRuleParams) ([]Rules, error) {
+	reqUrl := "loadbalancer/" + params.LoadbalancerId + "/rule"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var loadbalancerRule CreateResponse
+	resp, err := s.client.Do(req, &loadbalancerRule)
+	if err != nil {
+		return nil, err
+	}
+	if loadbalancerRule.Status != "success" && loadbalancerRule.Status != "" {
+		return nil, newErrorResponse(resp, loadbalancerRule.Message)
+	}
+
+	return s.ListRules(params.LoadbalancerId)
+}
+
+func (s *LoadbalancersService) ListRules(loadbalancerId string) ([]Rules, error) {
+	loadbalancer, err := s.Read(loadbalancerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadbalancer.Rules, nil
+}
+
+// DeleteRule removes a forwarding rule and returns the remaining rules of the load balancer
+func (s *LoadbalancersService) DeleteRule(loadbalancerId, loadbalancerRuleId string) ([]Rules, error) {
+	reqUrl := "loadbalancer/" + loadbalancerId + "/rule/" + loadbalancerRuleId
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return s.ListRules(loadbalancerId)
+}
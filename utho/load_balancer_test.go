@@ -446,6 +446,83 @@ func TestLoadbalancerService_DeleteFrontend_invalidServer(t *testing.T) {
 	}
 }
 
+func TestLoadbalancerService_AttachCertificate_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/11111/frontend/22222/certificate", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer token")
+
+		var body AttachLoadbalancerCertificateParams
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, "33333", body.CertificateID)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.Loadbalancers().AttachCertificate("11111", "22222", "33333")
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestLoadbalancerService_AttachCertificate_domainMismatch(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/11111/frontend/22222/certificate", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","message":"Certificate does not match the frontend domain"}`)
+	})
+
+	got, err := client.Loadbalancers().AttachCertificate("11111", "22222", "33333")
+
+	assert.ErrorContains(t, err, "Certificate does not match the frontend domain")
+	assert.Nil(t, got)
+}
+
+func TestLoadbalancerService_AttachCertificate_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Loadbalancers().AttachCertificate("11111", "22222", "33333")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestLoadbalancerService_DetachCertificate_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/11111/frontend/22222/certificate", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "DELETE")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, err := client.Loadbalancers().DetachCertificate("11111", "22222")
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestLoadbalancerService_DetachCertificate_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	delResponse, err := client.Loadbalancers().DetachCertificate("11111", "22222")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
 // loadbalancer Backend
 func TestLoadbalancerService_CreateBackend_happyPath(t *testing.T) {
 	token := "token"
@@ -738,3 +815,104 @@ func TestLoadbalancerService_DeleteRoute_invalidServer(t *testing.T) {
 		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
 	}
 }
+
+func TestLoadbalancerService_ReadBackend_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/1231", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","loadbalancers":[{"id":"1231","backends":[{"id":"1"}]}]}`)
+	})
+
+	backend, err := client.Loadbalancers().ReadBackend("1231", "2")
+	assert.EqualError(t, err, "404 loadbalancer backend 2 not found")
+	assert.Nil(t, backend)
+}
+
+func TestLoadbalancerService_ListBackends_emptyResponse(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/1231", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","loadbalancers":[]}`)
+	})
+
+	backends, err := client.Loadbalancers().ListBackends("1231")
+	assert.EqualError(t, err, "404 loadbalancer 1231 not found")
+	assert.Nil(t, backends)
+}
+
+func TestLoadbalancerService_AddRule_happyPath(t *testing.T) {
+	token := "token"
+	payload := CreateLoadbalancerRuleParams{
+		LoadbalancerId: "1231",
+		SrcProto:       "http",
+		SrcPort:        "80",
+		DstProto:       "https",
+		DstPort:        "443",
+	}
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/"+payload.LoadbalancerId+"/rule", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, map[string]any{"src_proto": "http", "src_port": "80", "dst_proto": "https", "dst_port": "443"}, got)
+
+		fmt.Fprint(w, dummyCreateResponseJson)
+	})
+	mux.HandleFunc("/loadbalancer/"+payload.LoadbalancerId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		fmt.Fprint(w, `{"status":"success","loadbalancers":[{"id":"1231","rules":[{"id":"7","src_proto":"http","src_port":"80","dst_proto":"https","dst_port":"443"}]}]}`)
+	})
+
+	got, err := client.Loadbalancers().AddRule(payload)
+
+	want := []Rules{{ID: "7", SrcProto: "http", SrcPort: "80", DstProto: "https", DstPort: "443"}}
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestLoadbalancerService_AddRule_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	rules, err := client.Loadbalancers().AddRule(CreateLoadbalancerRuleParams{})
+	assert.NotNil(t, err)
+	assert.Nil(t, rules)
+}
+
+func TestLoadbalancerService_DeleteRule_happyPath(t *testing.T) {
+	token := "token"
+	loadbalancerId := "1231"
+	ruleId := "7"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/loadbalancer/"+loadbalancerId+"/rule/"+ruleId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+	mux.HandleFunc("/loadbalancer/"+loadbalancerId, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","loadbalancers":[{"id":"1231","rules":[]}]}`)
+	})
+
+	got, err := client.Loadbalancers().DeleteRule(loadbalancerId, ruleId)
+
+	assert.Nil(t, err)
+	assert.Empty(t, got)
+}
+
+func TestLoadbalancerService_DeleteRule_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	rules, err := client.Loadbalancers().DeleteRule("1231", "7")
+	assert.NotNil(t, err)
+	assert.Nil(t, rules)
+}
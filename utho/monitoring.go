@@ -1,9 +1,5 @@
 package utho
 
-import (
-	"errors"
-)
-
 type MonitoringService service
 
 type Alerts struct {
@@ -51,15 +47,18 @@ type CreateAlertParams struct {
 
 func (s *MonitoringService) CreateAlert(params CreateAlertParams) (*BasicResponse, error) {
 	reqUrl := "alert"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var alert BasicResponse
-	_, err := s.client.Do(req, &alert)
+	resp, err := s.client.Do(req, &alert)
 	if err != nil {
 		return nil, err
 	}
 	if alert.Status != "success" && alert.Status != "" {
-		return nil, errors.New(alert.Message)
+		return nil, newErrorResponse(resp, alert.Message)
 	}
 
 	return &alert, nil
@@ -67,45 +66,50 @@ func (s *MonitoringService) CreateAlert(params CreateAlertParams) (*BasicRespons
 
 func (s *MonitoringService) ReadAlert(alertId string) (*Alert, error) {
 	reqUrl := "alert"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var alerts Alerts
-	_, err := s.client.Do(req, &alerts)
+	resp, err := s.client.Do(req, &alerts)
 	if err != nil {
 		return nil, err
 	}
 	if alerts.Status != "success" && alerts.Status != "" {
-		return nil, errors.New(alerts.Message)
+		return nil, newErrorResponse(resp, alerts.Message)
 	}
 
-	var alert Alert
 	for _, v := range alerts.Alerts {
 		if v.ID == alertId {
-			alert = v
+			return &v, nil
 		}
 	}
 
-	return &alert, nil
+	return nil, newNotFoundError("alert %s not found", alertId)
 }
 
 func (s *MonitoringService) ListAlerts() ([]Alert, error) {
 	reqUrl := "alert"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var alert Alerts
-	_, err := s.client.Do(req, &alert)
+	resp, err := s.client.Do(req, &alert)
 	if err != nil {
 		return nil, err
 	}
 	if alert.Status != "success" && alert.Status != "" {
-		return nil, errors.New(alert.Message)
+		return nil, newErrorResponse(resp, alert.Message)
 	}
 
 	return alert.Alerts, nil
 }
 
 type UpdateAlertParams struct {
-	AlertId  string
+	AlertId  string `json:"-"`
 	Name     string `json:"name"`
 	RefType  string `json:"ref_type"`
 	Type     string `json:"type"`
@@ -119,32 +123,41 @@ type UpdateAlertParams struct {
 
 func (s *MonitoringService) UpdateAlert(params UpdateAlertParams) (*BasicResponse, error) {
 	reqUrl := "alert/" + params.AlertId + "/update"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var alert BasicResponse
-	_, err := s.client.Do(req, &alert)
+	resp, err := s.client.Do(req, &alert)
 	if err != nil {
 		return nil, err
 	}
 	if alert.Status != "success" && alert.Status != "" {
-		return nil, errors.New(alert.Message)
+		return nil, newErrorResponse(resp, alert.Message)
 	}
 
 	return &alert, nil
 }
 
-// func (s *MonitoringService) DeleteAlert(alertId string) (*DeleteResponse, error) {
-// 	reqUrl := "alert/" + alertId
-// 	req, _ := s.client.NewRequest("DELETE", reqUrl)
+func (s *MonitoringService) DeleteAlert(alertId string) (*DeleteResponse, error) {
+	reqUrl := "alert/" + alertId + "/delete"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
-// 	var delResponse DeleteResponse
-// 	if _, err := s.client.Do(req, &delResponse); err != nil {
-// 		return nil, err
-// 	}
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
 
-//		return &delResponse, nil
-//	}
-//
+	return &delResponse, nil
+}
 
 // /////////////////////////////////////////////////////////////////
 
@@ -157,15 +170,18 @@ type CreateContactParams struct {
 
 func (s *MonitoringService) CreateContact(params CreateContactParams) (*CreateResponse, error) {
 	reqUrl := "alert/contact/add"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var contact CreateResponse
-	_, err := s.client.Do(req, &contact)
+	resp, err := s.client.Do(req, &contact)
 	if err != nil {
 		return nil, err
 	}
 	if contact.Status != "success" && contact.Status != "" {
-		return nil, errors.New(contact.Message)
+		return nil, newErrorResponse(resp, contact.Message)
 	}
 
 	return &contact, nil
@@ -173,15 +189,18 @@ func (s *MonitoringService) CreateContact(params CreateContactParams) (*CreateRe
 
 func (s *MonitoringService) ReadContact(contactId string) (*Contact, error) {
 	reqUrl := "alert/contact/list"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var contacts Contacts
-	_, err := s.client.Do(req, &contacts)
+	resp, err := s.client.Do(req, &contacts)
 	if err != nil {
 		return nil, err
 	}
 	if contacts.Status != "success" && contacts.Status != "" {
-		return nil, errors.New(contacts.Message)
+		return nil, newErrorResponse(resp, contacts.Message)
 	}
 
 	var contact Contact
@@ -196,15 +215,18 @@ func (s *MonitoringService) ReadContact(contactId string) (*Contact, error) {
 
 func (s *MonitoringService) ListContacts() ([]Contact, error) {
 	reqUrl := "alert/contact/list"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var contact Contacts
-	_, err := s.client.Do(req, &contact)
+	resp, err := s.client.Do(req, &contact)
 	if err != nil {
 		return nil, err
 	}
 	if contact.Status != "success" && contact.Status != "" {
-		return nil, errors.New(contact.Message)
+		return nil, newErrorResponse(resp, contact.Message)
 	}
 
 	return contact.Contacts, nil
@@ -220,15 +242,18 @@ type UpdateContactParams struct {
 
 func (s *MonitoringService) UpdateContact(params UpdateContactParams) (*BasicResponse, error) {
 	reqUrl := "alert/contact/" + params.ContactId + "/update"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var contact BasicResponse
-	_, err := s.client.Do(req, &contact)
+	resp, err := s.client.Do(req, &contact)
 	if err != nil {
 		return nil, err
 	}
 	if contact.Status != "success" && contact.Status != "" {
-		return nil, errors.New(contact.Message)
+		return nil, newErrorResponse(resp, contact.Message)
 	}
 
 	return &contact, nil
@@ -236,14 +261,18 @@ func (s *MonitoringService) UpdateContact(params UpdateContactParams) (*BasicRes
 
 func (s *MonitoringService) DeleteContact(contactId string) (*DeleteResponse, error) {
 	reqUrl := "alert/contact/" + contactId + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -349,3 +349,48 @@ const dummyReadContactRes = `{
 	"slack": "",
 	"mobilenumber": "11111111"
 }`
+
+func TestMonitoringService_DeleteAlert_happyPath(t *testing.T) {
+	token := "token"
+	alertId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/alert/"+alertId+"/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	want := DeleteResponse{Status: "success", Message: "success"}
+
+	got, err := client.Monitoring().DeleteAlert(alertId)
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestMonitoringService_DeleteAlert_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	delResponse, err := client.Monitoring().DeleteAlert("11111")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
+func TestMonitoringService_ReadAlert_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/alert", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","alerts":[{"id":"1"}]}`)
+	})
+
+	alert, err := client.Monitoring().ReadAlert("2")
+	assert.EqualError(t, err, "404 alert 2 not found")
+	assert.Nil(t, alert)
+}
@@ -1,9 +1,5 @@
 package utho
 
-import (
-	"errors"
-)
-
 type ObjectStorageService service
 
 type Buckets struct {
@@ -98,15 +94,18 @@ type CreateBucketParams struct {
 
 func (s *ObjectStorageService) CreateBucket(params CreateBucketParams) (*CreateResponse, error) {
 	reqUrl := "objectstorage/bucket/create"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var bucket CreateResponse
-	_, err := s.client.Do(req, &bucket)
+	resp, err := s.client.Do(req, &bucket)
 	if err != nil {
 		return nil, err
 	}
 	if bucket.Status != "success" && bucket.Status != "" {
-		return nil, errors.New(bucket.Message)
+		return nil, newErrorResponse(resp, bucket.Message)
 	}
 
 	return &bucket, nil
@@ -114,15 +113,18 @@ func (s *ObjectStorageService) CreateBucket(params CreateBucketParams) (*CreateR
 
 func (s *ObjectStorageService) ReadBucket(dcslug, bucketName string) (*Bucket, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var buckets Buckets
-	_, err := s.client.Do(req, &buckets)
+	resp, err := s.client.Do(req, &buckets)
 	if err != nil {
 		return nil, err
 	}
 	if buckets.Status != "success" && buckets.Status != "" {
-		return nil, errors.New(buckets.Message)
+		return nil, newErrorResponse(resp, buckets.Message)
 	}
 
 	var bucket Bucket
@@ -132,7 +134,7 @@ func (s *ObjectStorageService) ReadBucket(dcslug, bucketName string) (*Bucket, e
 		}
 	}
 	if len(bucket.Name) == 0 {
-		return nil, errors.New("bucket not found")
+		return nil, newNotFoundError("bucket not found")
 	}
 
 	return &bucket, nil
@@ -140,15 +142,18 @@ func (s *ObjectStorageService) ReadBucket(dcslug, bucketName string) (*Bucket, e
 
 func (s *ObjectStorageService) ListBuckets(dcslug string) ([]Bucket, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var buckets Buckets
-	_, err := s.client.Do(req, &buckets)
+	resp, err := s.client.Do(req, &buckets)
 	if err != nil {
 		return nil, err
 	}
 	if buckets.Status != "success" && buckets.Status != "" {
-		return nil, errors.New(buckets.Message)
+		return nil, newErrorResponse(resp, buckets.Message)
 	}
 
 	return buckets.Buckets, nil
@@ -156,14 +161,18 @@ func (s *ObjectStorageService) ListBuckets(dcslug string) ([]Bucket, error) {
 
 func (s *ObjectStorageService) DeleteBucket(dcslug, bucketName string) (*DeleteResponse, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket/" + bucketName + "/delete"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -182,15 +191,18 @@ type CreateAccessKeyResponse struct {
 
 func (s *ObjectStorageService) CreateAccessKey(params CreateAccessKeyParams) (*CreateAccessKeyResponse, error) {
 	reqUrl := "objectstorage/" + params.Dcslug + "/accesskey/create"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var accesskey CreateAccessKeyResponse
-	_, err := s.client.Do(req, &accesskey)
+	resp, err := s.client.Do(req, &accesskey)
 	if err != nil {
 		return nil, err
 	}
 	if accesskey.Status != "success" && accesskey.Status != "" {
-		return nil, errors.New(accesskey.Message)
+		return nil, newErrorResponse(resp, accesskey.Message)
 	}
 
 	return &accesskey, nil
@@ -198,15 +210,18 @@ func (s *ObjectStorageService) CreateAccessKey(params CreateAccessKeyParams) (*C
 
 func (s *ObjectStorageService) ReadAccessKey(dcslug, accesskeyName string) (*AccessKey, error) {
 	reqUrl := "objectstorage/" + dcslug + "/accesskeys"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var accesskeys AccessKeys
-	_, err := s.client.Do(req, &accesskeys)
+	resp, err := s.client.Do(req, &accesskeys)
 	if err != nil {
 		return nil, err
 	}
 	if accesskeys.Status != "success" && accesskeys.Status != "" {
-		return nil, errors.New(accesskeys.Message)
+		return nil, newErrorResponse(resp, accesskeys.Message)
 	}
 
 	var accesskey AccessKey
@@ -216,23 +231,43 @@ func (s *ObjectStorageService) ReadAccessKey(dcslug, accesskeyName string) (*Acc
 		}
 	}
 	if len(accesskey.Name) == 0 {
-		return nil, errors.New("access key not found")
+		return nil, newNotFoundError("access key %s not found", accesskeyName)
 	}
 
 	return &accesskey, nil
 }
 
+// ReadBucketAccessKey returns the permission an access key has on a bucket, without its secret.
+// An access key without access to the bucket, or that no longer exists, is reported as a 404 *ErrorResponse.
+func (s *ObjectStorageService) ReadBucketAccessKey(dcslug, bucketName, accesskey string) (*Permissions, error) {
+	bucket, err := s.ReadBucket(dcslug, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range bucket.Permissions {
+		if permission.Accesskey == accesskey {
+			return &permission, nil
+		}
+	}
+
+	return nil, newNotFoundError("access key %s not found on bucket %s", accesskey, bucketName)
+}
+
 func (s *ObjectStorageService) ListAccessKeys(dcslug string) ([]AccessKey, error) {
 	reqUrl := "objectstorage/" + dcslug + "/accesskeys"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var accesskeys AccessKeys
-	_, err := s.client.Do(req, &accesskeys)
+	resp, err := s.client.Do(req, &accesskeys)
 	if err != nil {
 		return nil, err
 	}
 	if accesskeys.Status != "success" && accesskeys.Status != "" {
-		return nil, errors.New(accesskeys.Message)
+		return nil, newErrorResponse(resp, accesskeys.Message)
 	}
 
 	return accesskeys.AccessKeys, nil
@@ -258,14 +293,17 @@ type UpdateBucketAccessControlParams struct {
 
 func (s *ObjectStorageService) UpdateBucketAccessControl(params UpdateBucketAccessControlParams) (*CreateResponse, error) {
 	reqUrl := "objectstorage/" + params.Dcslug + "/bucket/" + params.BucketName + "/policy/" + params.Policy
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 	var bucket CreateResponse
-	_, err := s.client.Do(req, &bucket)
+	resp, err := s.client.Do(req, &bucket)
 	if err != nil {
 		return nil, err
 	}
 	if bucket.Status != "success" && bucket.Status != "" {
-		return nil, errors.New(bucket.Message)
+		return nil, newErrorResponse(resp, bucket.Message)
 	}
 
 	return &bucket, nil
@@ -279,15 +317,18 @@ type CreateDirectroyParams struct {
 
 func (s *ObjectStorageService) CreateDirectroy(params CreateDirectroyParams) (*CreateResponse, error) {
 	reqUrl := "objectstorage/" + params.Dcslug + "/bucket/" + params.BucketName + "/createdirectory"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var accesskey CreateResponse
-	_, err := s.client.Do(req, &accesskey)
+	resp, err := s.client.Do(req, &accesskey)
 	if err != nil {
 		return nil, err
 	}
 	if accesskey.Status != "success" && accesskey.Status != "" {
-		return nil, errors.New(accesskey.Message)
+		return nil, newErrorResponse(resp, accesskey.Message)
 	}
 
 	return &accesskey, nil
@@ -295,15 +336,18 @@ func (s *ObjectStorageService) CreateDirectroy(params CreateDirectroyParams) (*C
 
 func (s *ObjectStorageService) ListBucketObjectsAndDirectories(dcslug, bucketName, path string) ([]Object, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket/" + bucketName + "/objects?path=" + path
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var objects Objects
-	_, err := s.client.Do(req, &objects)
+	resp, err := s.client.Do(req, &objects)
 	if err != nil {
 		return nil, err
 	}
 	if objects.Status != "success" && objects.Status != "" {
-		return nil, errors.New(objects.Message)
+		return nil, newErrorResponse(resp, objects.Message)
 	}
 
 	return objects.Objects, nil
@@ -311,14 +355,18 @@ func (s *ObjectStorageService) ListBucketObjectsAndDirectories(dcslug, bucketNam
 
 func (s *ObjectStorageService) DeleteDirectroy(dcslug, bucketName, directoryName string) (*DeleteResponse, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket/" + bucketName + "/delete/object?path=" + directoryName
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -332,15 +380,18 @@ type GetSharableUrlOfObject struct {
 
 func (s *ObjectStorageService) GetSharableUrlOfObject(dcslug, bucketName, path string) (*GetSharableUrlOfObject, error) {
 	reqUrl := "objectstorage/" + dcslug + "/bucket/" + bucketName + "/download?path=" + path
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var object GetSharableUrlOfObject
-	_, err := s.client.Do(req, &object)
+	resp, err := s.client.Do(req, &object)
 	if err != nil {
 		return nil, err
 	}
 	if object.Status != "success" && object.Status != "" {
-		return nil, errors.New(object.Message)
+		return nil, newErrorResponse(resp, object.Message)
 	}
 
 	return &object, nil
@@ -348,15 +399,18 @@ func (s *ObjectStorageService) GetSharableUrlOfObject(dcslug, bucketName, path s
 
 func (s *ObjectStorageService) ListSubscriptionPlanPricing() ([]Pricing, error) {
 	reqUrl := "pricing/objectstorage"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var planList PlanList
-	_, err := s.client.Do(req, &planList)
+	resp, err := s.client.Do(req, &planList)
 	if err != nil {
 		return nil, err
 	}
 	if planList.Status != "success" && planList.Status != "" {
-		return nil, errors.New(planList.Message)
+		return nil, newErrorResponse(resp, planList.Message)
 	}
 
 	return planList.Pricing, nil
@@ -371,15 +425,18 @@ type UpdateBucketAccessKeyPermissionParams struct {
 
 func (s *ObjectStorageService) UpdateBucketAccessKeyPermission(params UpdateBucketAccessKeyPermissionParams) (*CreateResponse, error) {
 	reqUrl := "objectstorage/" + params.Dcslug + "/bucket/" + params.BucketName + "/permission/" + params.PermissionName + "/accesskey/" + params.AccessKeyId
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var permission CreateResponse
-	_, err := s.client.Do(req, &permission)
+	resp, err := s.client.Do(req, &permission)
 	if err != nil {
 		return nil, err
 	}
 	if permission.Status != "success" && permission.Status != "" {
-		return nil, errors.New(permission.Message)
+		return nil, newErrorResponse(resp, permission.Message)
 	}
 
 	return &permission, nil
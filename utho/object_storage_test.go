@@ -229,6 +229,64 @@ func TestObjectStorageService_ReadAccessKey_invalidServer(t *testing.T) {
 	}
 }
 
+func TestObjectStorageService_ReadAccessKey_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/objectstorage/innoida/accesskeys", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"accesskeys":[],"status":"success"}`)
+	})
+
+	got, err := client.ObjectStorage().ReadAccessKey("innoida", "gone")
+
+	assert.ErrorIs(t, err, &ErrorResponse{StatusCode: http.StatusNotFound})
+	assert.EqualError(t, err, "404 access key gone not found")
+	assert.Nil(t, got)
+}
+
+func TestObjectStorageService_ReadBucketAccessKey_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/objectstorage/innoida/bucket", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, dummyReadBucketServerRes)
+	})
+
+	got, err := client.ObjectStorage().ReadBucketAccessKey("innoida", "examplename", "caSu9smRk4eW3fZUpoiGTYwDBzOqhdxjK2lI")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "write", got.Permission)
+	assert.Equal(t, "2024-05-05 20:14:40", got.CreatedAt)
+}
+
+func TestObjectStorageService_ReadBucketAccessKey_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/objectstorage/innoida/bucket", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyReadBucketServerRes)
+	})
+
+	got, err := client.ObjectStorage().ReadBucketAccessKey("innoida", "examplename", "deletedKey")
+
+	assert.ErrorIs(t, err, &ErrorResponse{StatusCode: http.StatusNotFound})
+	assert.Nil(t, got)
+}
+
+func TestObjectStorageService_ReadBucketAccessKey_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	permission, err := client.ObjectStorage().ReadBucketAccessKey("innoida", "examplename", "someKey")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if permission != nil {
+		t.Errorf("Was not expecting any permission to be returned, instead got %v", permission)
+	}
+}
+
 func TestObjectStorageService_ListAccessKey_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
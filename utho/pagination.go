@@ -0,0 +1,42 @@
+package utho
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListOptions selects a page of results on list endpoints that support pagination
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
+// addListOptions appends the first of opts, if any, to reqUrl as query parameters
+func addListOptions(reqUrl string, opts []ListOptions) string {
+	if len(opts) == 0 {
+		return reqUrl
+	}
+
+	query := url.Values{}
+	if opts[0].Page > 0 {
+		query.Set("page", strconv.Itoa(opts[0].Page))
+	}
+	if opts[0].PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(opts[0].PerPage))
+	}
+
+	return addQuery(reqUrl, query)
+}
+
+// addQuery appends query to reqUrl, which may already carry query parameters
+func addQuery(reqUrl string, query url.Values) string {
+	if len(query) == 0 {
+		return reqUrl
+	}
+	if strings.Contains(reqUrl, "?") {
+		return reqUrl + "&" + query.Encode()
+	}
+
+	return reqUrl + "?" + query.Encode()
+}
@@ -0,0 +1,127 @@
+package utho
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// send performs req, retrying transient failures according to the client's retry policy.
+// The retry count set on the client can be overridden per call with WithCallMaxRetries.
+func (c *client) send(req *http.Request) (*http.Response, error) {
+	maxRetries := c.maxRetries
+	if o, ok := req.Context().Value(callOptionsKey{}).(callOptions); ok && o.maxRetries != nil {
+		maxRetries = *o.maxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			// the previous attempt consumed the body
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.client.Do(req)
+		if attempt >= maxRetries || !c.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		delay := c.retryDelay(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// RetryPredicate reports whether an attempt may be repeated, given the request and either
+// the response or the error returned by the transport. It is called after every attempt,
+// successful ones included, and must not read resp.Body.
+type RetryPredicate func(req *http.Request, resp *http.Response, err error) bool
+
+func (c *client) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if c.retryPredicate != nil {
+		return c.retryPredicate(req, resp, err)
+	}
+	return DefaultRetryPredicate(req, resp, err)
+}
+
+// DefaultRetryPredicate is the RetryPredicate used unless WithRetryPredicate is given.
+// GET and DELETE requests are retried on 429 and 5xx responses and on any transport error.
+// Other requests, such as a POST, are only retried when the connection could not be established,
+// since an error after the request was sent doesn't tell whether the API acted on it and retrying
+// could create a resource twice. An Idempotency-Key header doesn't change this: the API doesn't
+// document deduplicating requests on it.
+func DefaultRetryPredicate(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil && req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodDelete
+	if err != nil {
+		return idempotent || isDialError(err)
+	}
+	if !idempotent {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// isDialError reports whether err happened while connecting, before any of the request was sent
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+const (
+	// defaultRetryBaseDelay is the base delay of retries requested with WithCallMaxRetries on a client without WithRetry
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	// maxRetryDelay caps the exponential backoff between two attempts
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryDelay honours the Retry-After header when present and otherwise
+// backs off exponentially from the base delay, with jitter.
+func (c *client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return max(time.Until(date), 0)
+			}
+		}
+	}
+
+	base := c.retryBaseDelay
+	if base <= 0 {
+		// retries enabled with WithCallMaxRetries alone
+		base = defaultRetryBaseDelay
+	}
+	delay := maxRetryDelay
+	if attempt < 32 && base <= maxRetryDelay>>attempt {
+		delay = base << attempt
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,273 @@
+package utho
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupWithRetry(t *testing.T, handler http.HandlerFunc) Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("token", WithBaseURL(server.URL+"/v2/"), WithRetry(3, time.Millisecond))
+	assert.Nil(t, err)
+
+	return client
+}
+
+func TestRetry_idempotentRequest(t *testing.T) {
+	calls := 0
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	got, err := client.ApiKey().Delete("someId")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "success", got.Status)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetry_givesUp(t *testing.T) {
+	calls := 0
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.CloudInstances().List()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestRetry_postNotRetriedOnResponse(t *testing.T) {
+	calls := 0
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Vpc().Create(CreateVpcParams{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_postWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		keys = append(keys, req.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	var payload CreateCloudInstanceParams
+	_ = json.Unmarshal([]byte(dummyCreateCloudInstanceRequestJson), &payload)
+
+	_, err := client.CloudInstances().Create(payload, WithIdempotencyKey("someKey"))
+
+	// the key is only a hint, a POST that reached the API is never repeated
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"someKey"}, keys)
+}
+
+func TestRetry_rebuffersBody(t *testing.T) {
+	var bodies []string
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		buf := make([]byte, req.ContentLength)
+		_, _ = req.Body.Read(buf)
+		bodies = append(bodies, string(buf))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	_, err := client.CloudInstances().Delete("someId", DeleteCloudInstanceParams{Confirm: "yes"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"{\"confirm\":\"yes\"}\n", "{\"confirm\":\"yes\"}\n"}, bodies)
+}
+
+func TestRetry_callOverride(t *testing.T) {
+	calls := 0
+	client := setupWithRetry(t, func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.CloudInstances().Delete("someId", DeleteCloudInstanceParams{}, WithCallMaxRetries(0))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDefaultRetryPredicate(t *testing.T) {
+	connErr := errors.New("connection reset by peer")
+	dialErr := &url.Error{Op: "Post", URL: "https://api.utho.com/v2/cloud", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	readErr := &url.Error{Op: "Post", URL: "https://api.utho.com/v2/cloud", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}}
+
+	tests := []struct {
+		method         string
+		idempotencyKey string
+		status         int
+		err            error
+		want           bool
+	}{
+		{method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{method: http.MethodGet, status: http.StatusInternalServerError, want: true},
+		{method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodGet, status: http.StatusGatewayTimeout, want: true},
+		{method: http.MethodGet, status: http.StatusNotFound, want: false},
+		{method: http.MethodGet, status: http.StatusOK, want: false},
+		{method: http.MethodGet, err: connErr, want: true},
+		{method: http.MethodDelete, status: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodDelete, status: http.StatusInternalServerError, want: true},
+		{method: http.MethodDelete, err: readErr, want: true},
+		{method: http.MethodPut, status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPut, err: readErr, want: false},
+		{method: http.MethodPut, err: dialErr, want: true},
+		{method: http.MethodHead, status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPost, status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPost, status: http.StatusTooManyRequests, want: false},
+		{method: http.MethodPost, err: connErr, want: false},
+		{method: http.MethodPost, err: readErr, want: false},
+		{method: http.MethodPost, err: dialErr, want: true},
+		{method: http.MethodPost, idempotencyKey: "someKey", status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPost, idempotencyKey: "someKey", err: readErr, want: false},
+		{method: http.MethodPost, idempotencyKey: "someKey", err: dialErr, want: true},
+		{method: http.MethodPatch, status: http.StatusServiceUnavailable, want: false},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest(tt.method, "https://api.utho.com/v2/cloud", nil)
+		if tt.idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+		}
+		var resp *http.Response
+		if tt.err == nil {
+			resp = &http.Response{StatusCode: tt.status}
+		}
+
+		got := DefaultRetryPredicate(req, resp, tt.err)
+		assert.Equal(t, tt.want, got, "%s %d %v idempotency key %q", tt.method, tt.status, tt.err, tt.idempotencyKey)
+	}
+}
+
+func TestDefaultRetryPredicate_canceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.utho.com/v2/cloud", nil)
+
+	assert.False(t, DefaultRetryPredicate(req, nil, context.Canceled))
+}
+
+func TestWithRetryPredicate(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, dummyCreateResponseJson)
+	}))
+	defer server.Close()
+
+	var seen []int
+	predicate := func(req *http.Request, resp *http.Response, err error) bool {
+		seen = append(seen, resp.StatusCode)
+		return req.Method == http.MethodPost && resp.StatusCode >= 500
+	}
+	client, err := NewClient("token", WithBaseURL(server.URL+"/v2/"), WithRetry(3, time.Millisecond), WithRetryPredicate(predicate))
+	assert.Nil(t, err)
+
+	_, err = client.Vpc().Create(CreateVpcParams{Dcslug: "innoida", Name: "vpc", Planid: "1", Network: "10.0.0.0", Size: "24"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []int{http.StatusInternalServerError, http.StatusOK}, seen)
+}
+
+func TestWithRetryPredicate_invalid(t *testing.T) {
+	_, err := NewClient("token", WithRetryPredicate(nil))
+	assert.NotNil(t, err)
+}
+
+func TestRetry_retryAfter(t *testing.T) {
+	c := &client{retryBaseDelay: time.Hour}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	assert.Equal(t, 2*time.Second, c.retryDelay(0, resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), c.retryDelay(0, resp))
+}
+
+func TestRetry_backoff(t *testing.T) {
+	c := &client{retryBaseDelay: 100 * time.Millisecond}
+
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		got := c.retryDelay(attempt, nil)
+		assert.GreaterOrEqual(t, got, want/2)
+		assert.LessOrEqual(t, got, want)
+	}
+}
+
+func TestRetry_backoffDefaultsAndCap(t *testing.T) {
+	c := &client{}
+	got := c.retryDelay(0, nil)
+	assert.GreaterOrEqual(t, got, defaultRetryBaseDelay/2)
+	assert.LessOrEqual(t, got, defaultRetryBaseDelay)
+
+	c = &client{retryBaseDelay: time.Second}
+	for _, attempt := range []int{10, 40, 63, 100} {
+		got := c.retryDelay(attempt, nil)
+		assert.GreaterOrEqual(t, got, maxRetryDelay/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, got, maxRetryDelay, "attempt %d", attempt)
+	}
+}
+
+func TestRetry_callMaxRetriesWithoutWithRetry(t *testing.T) {
+	var attempts []time.Time
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		attempts = append(attempts, time.Now())
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.CloudInstances().Read("someId", WithCallMaxRetries(1))
+
+	assert.NotNil(t, err)
+	if assert.Len(t, attempts, 2) {
+		assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), defaultRetryBaseDelay/2)
+	}
+}
+
+func TestWithRetry_invalid(t *testing.T) {
+	_, err := NewClient("token", WithRetry(-1, time.Second))
+	assert.NotNil(t, err)
+
+	_, err = NewClient("token", WithRetry(3, 0))
+	assert.NotNil(t, err)
+}
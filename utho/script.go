@@ -0,0 +1,40 @@
+package utho
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+)
+
+// Script holds a shell script for the script field of CreateStacksParams and UpdateStacksParams
+type Script string
+
+// ScriptFromFile reads a script from disk, normalising Windows line endings to "\n"
+func ScriptFromFile(path string) (Script, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return Script(strings.ReplaceAll(string(data), "\r\n", "\n")), nil
+}
+
+// Size returns the length of the script in bytes, before any encoding
+func (s Script) Size() int {
+	return len(s)
+}
+
+// Validate checks that the script is not empty. The API documents no size limit, so none is enforced here.
+func (s Script) Validate() error {
+	if strings.TrimSpace(string(s)) == "" {
+		return errors.New("script can't be empty")
+	}
+
+	return nil
+}
+
+// Base64 returns the script encoded with standard base64, for fields that expect encoded content
+func (s Script) Base64() string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
@@ -0,0 +1,37 @@
+package utho
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptFromFile_normalisesLineEndings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "setup.sh")
+	_ = os.WriteFile(path, []byte("#!/bin/sh\r\necho 'hello'\r\n"), 0o600)
+
+	got, err := ScriptFromFile(path)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Script("#!/bin/sh\necho 'hello'\n"), got)
+	assert.Equal(t, 23, got.Size())
+}
+
+func TestScriptFromFile_missingFile(t *testing.T) {
+	_, err := ScriptFromFile(filepath.Join(t.TempDir(), "missing.sh"))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestScript_Validate(t *testing.T) {
+	assert.Nil(t, Script("echo 'hello'").Validate())
+	assert.NotNil(t, Script("").Validate())
+	assert.NotNil(t, Script(" \n").Validate())
+}
+
+func TestScript_Base64(t *testing.T) {
+	assert.Equal(t, "ZWNobyAnaGVsbG8n", Script("echo 'hello'").Base64())
+}
@@ -1,7 +1,7 @@
 package utho
 
 import (
-	"errors"
+	"net/url"
 )
 
 type SqsService service
@@ -30,15 +30,18 @@ type CreateSqsParams struct {
 
 func (s *SqsService) Create(params CreateSqsParams) (*CreateResponse, error) {
 	reqUrl := "sqs"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var sqs CreateResponse
-	_, err := s.client.Do(req, &sqs)
+	resp, err := s.client.Do(req, &sqs)
 	if err != nil {
 		return nil, err
 	}
 	if sqs.Status != "success" && sqs.Status != "" {
-		return nil, errors.New(sqs.Message)
+		return nil, newErrorResponse(resp, sqs.Message)
 	}
 
 	return &sqs, nil
@@ -46,18 +49,21 @@ func (s *SqsService) Create(params CreateSqsParams) (*CreateResponse, error) {
 
 func (s *SqsService) Read(sqsId string) (*Sqs, error) {
 	reqUrl := "sqs/" + sqsId
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var sqs Sqss
-	_, err := s.client.Do(req, &sqs)
+	resp, err := s.client.Do(req, &sqs)
 	if err != nil {
 		return nil, err
 	}
 	if sqs.Status != "success" && sqs.Status != "" {
-		return nil, errors.New(sqs.Message)
+		return nil, newErrorResponse(resp, sqs.Message)
 	}
 	if len(sqs.Sqs) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("sqs %s not found", sqsId)
 	}
 
 	return &sqs.Sqs[0], nil
@@ -65,30 +71,37 @@ func (s *SqsService) Read(sqsId string) (*Sqs, error) {
 
 func (s *SqsService) List() ([]Sqs, error) {
 	reqUrl := "sqs"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var sqs Sqss
-	_, err := s.client.Do(req, &sqs)
+	resp, err := s.client.Do(req, &sqs)
 	if err != nil {
 		return nil, err
 	}
 	if sqs.Status != "success" && sqs.Status != "" {
-		return nil, errors.New(sqs.Message)
+		return nil, newErrorResponse(resp, sqs.Message)
 	}
 
 	return sqs.Sqs, nil
 }
 
 func (s *SqsService) Delete(sqsId, sqsName string) (*DeleteResponse, error) {
-	reqUrl := "sqs/" + sqsId + "/destroy?confirm=" + sqsName
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	reqUrl := "sqs/" + sqsId + "/destroy?confirm=" + url.QueryEscape(sqsName)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -121,7 +121,7 @@ func TestSqsService_List_invalidServer(t *testing.T) {
 func TestSqsService_Delete_happyPath(t *testing.T) {
 	token := "token"
 	sqsId := "someSqsId"
-	sqsname := "someSqsname"
+	sqsname := "some sqs&name"
 
 	client, mux, _, teardown := setup(token)
 	defer teardown()
@@ -129,6 +129,9 @@ func TestSqsService_Delete_happyPath(t *testing.T) {
 	mux.HandleFunc("/sqs/"+sqsId+"/destroy", func(w http.ResponseWriter, req *http.Request) {
 		testHttpMethod(t, req, "DELETE")
 		testHeader(t, req, "Authorization", "Bearer "+token)
+		if got := req.URL.Query().Get("confirm"); got != sqsname {
+			t.Errorf("confirm = %q, want %q", got, sqsname)
+		}
 		fmt.Fprint(w, dummyDeleteResponseJson)
 	})
 
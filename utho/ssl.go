@@ -2,6 +2,8 @@ package utho
 
 import (
 	"errors"
+	"strings"
+	"time"
 )
 
 type SslService service
@@ -29,6 +31,27 @@ type Certificates struct {
 	DeletedAt        string `json:"deleted_at"`
 }
 
+// SANs returns the DNS names covered by the certificate
+func (c Certificates) SANs() []string {
+	return strings.FieldsFunc(c.DNSNames, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// Expiry returns the time the certificate expires at
+func (c Certificates) Expiry() (time.Time, error) {
+	if c.ExpireAt == "" {
+		return time.Time{}, errors.New("certificate has no expiry date")
+	}
+
+	return parseTimestamp(c.ExpireAt)
+}
+
+// Created returns the time the certificate was uploaded at
+func (c Certificates) Created() (time.Time, error) {
+	return parseTimestamp(c.CreatedAt)
+}
+
 type CreateSslParams struct {
 	Name             string `json:"name"`
 	Type             string `json:"type"`
@@ -39,15 +62,18 @@ type CreateSslParams struct {
 
 func (s *SslService) Create(params CreateSslParams) (*CreateResponse, error) {
 	reqUrl := "certificates"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var ssl CreateResponse
-	_, err := s.client.Do(req, &ssl)
+	resp, err := s.client.Do(req, &ssl)
 	if err != nil {
 		return nil, err
 	}
 	if ssl.Status != "success" && ssl.Status != "" {
-		return nil, errors.New(ssl.Message)
+		return nil, newErrorResponse(resp, ssl.Message)
 	}
 
 	return &ssl, nil
@@ -55,15 +81,18 @@ func (s *SslService) Create(params CreateSslParams) (*CreateResponse, error) {
 
 func (s *SslService) Read(certId string) (*Certificates, error) {
 	reqUrl := "certificates"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var ssl Ssls
-	_, err := s.client.Do(req, &ssl)
+	resp, err := s.client.Do(req, &ssl)
 	if err != nil {
 		return nil, err
 	}
 	if ssl.Status != "success" && ssl.Status != "" {
-		return nil, errors.New(ssl.Message)
+		return nil, newErrorResponse(resp, ssl.Message)
 	}
 
 	var cert Certificates
@@ -73,7 +102,7 @@ func (s *SslService) Read(certId string) (*Certificates, error) {
 		}
 	}
 	if len(cert.ID) == 0 {
-		return nil, errors.New("certificate not found")
+		return nil, newNotFoundError("certificate not found")
 	}
 
 	return &cert, nil
@@ -81,15 +110,18 @@ func (s *SslService) Read(certId string) (*Certificates, error) {
 
 func (s *SslService) List() ([]Certificates, error) {
 	reqUrl := "certificates"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var ssl Ssls
-	_, err := s.client.Do(req, &ssl)
+	resp, err := s.client.Do(req, &ssl)
 	if err != nil {
 		return nil, err
 	}
 	if ssl.Status != "success" && ssl.Status != "" {
-		return nil, errors.New(ssl.Message)
+		return nil, newErrorResponse(resp, ssl.Message)
 	}
 
 	return ssl.Certificates, nil
@@ -97,14 +129,18 @@ func (s *SslService) List() ([]Certificates, error) {
 
 func (s *SslService) Delete(certId string) (*DeleteResponse, error) {
 	reqUrl := "certificates/" + certId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
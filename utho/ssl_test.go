@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -153,6 +154,24 @@ func TestSslService_DeleteSsl_invalidServer(t *testing.T) {
 	}
 }
 
+func TestCertificates_SANs(t *testing.T) {
+	cert := Certificates{DNSNames: "example.com, www.example.com,api.example.com"}
+
+	assert.Equal(t, []string{"example.com", "www.example.com", "api.example.com"}, cert.SANs())
+	assert.Empty(t, Certificates{}.SANs())
+}
+
+func TestCertificates_Expiry(t *testing.T) {
+	cert := Certificates{ExpireAt: "2025-05-09 13:46:05"}
+
+	got, err := cert.Expiry()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, time.May, 9, 13, 46, 5, 0, time.UTC), got)
+
+	_, err = Certificates{}.Expiry()
+	assert.NotNil(t, err)
+}
+
 const dummyReadSslRes = `{
 	"id": "11111",
 	"userid": "11111",
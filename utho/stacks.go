@@ -30,20 +30,27 @@ type CreateStacksParams struct {
 	Description string `json:"description"`
 	Images      string `json:"images"`
 	IsPublic    string `json:"is_public"`
-	Script      string `json:"script"`
+	Script      Script `json:"script"`
 }
 
 func (s *StacksService) Create(params CreateStacksParams) (*CreateResponse, error) {
+	if err := params.Script.Validate(); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "stacks"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var stacks CreateResponse
-	_, err := s.client.Do(req, &stacks)
+	resp, err := s.client.Do(req, &stacks)
 	if err != nil {
 		return nil, err
 	}
 	if stacks.Status != "success" && stacks.Status != "" {
-		return nil, errors.New(stacks.Message)
+		return nil, newErrorResponse(resp, stacks.Message)
 	}
 
 	return &stacks, nil
@@ -51,15 +58,18 @@ func (s *StacksService) Create(params CreateStacksParams) (*CreateResponse, erro
 
 func (s *StacksService) Read(stackId string) (*Stack, error) {
 	reqUrl := "stacks"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var stacks Stacks
-	_, err := s.client.Do(req, &stacks)
+	resp, err := s.client.Do(req, &stacks)
 	if err != nil {
 		return nil, err
 	}
 	if stacks.Status != "success" && stacks.Status != "" {
-		return nil, errors.New(stacks.Message)
+		return nil, newErrorResponse(resp, stacks.Message)
 	}
 
 	var stack Stack
@@ -69,7 +79,7 @@ func (s *StacksService) Read(stackId string) (*Stack, error) {
 		}
 	}
 	if len(stack.ID) == 0 {
-		return nil, errors.New("stack not found")
+		return nil, newNotFoundError("stack not found")
 	}
 
 	return &stack, nil
@@ -77,15 +87,18 @@ func (s *StacksService) Read(stackId string) (*Stack, error) {
 
 func (s *StacksService) List() ([]Stack, error) {
 	reqUrl := "stacks"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var stacks Stacks
-	_, err := s.client.Do(req, &stacks)
+	resp, err := s.client.Do(req, &stacks)
 	if err != nil {
 		return nil, err
 	}
 	if stacks.Status != "success" && stacks.Status != "" {
-		return nil, errors.New(stacks.Message)
+		return nil, newErrorResponse(resp, stacks.Message)
 	}
 
 	return stacks.Stacks, nil
@@ -97,20 +110,27 @@ type UpdateStacksParams struct {
 	Description string `json:"description"`
 	Images      string `json:"images"`
 	IsPublic    string `json:"is_public"`
-	Script      string `json:"script"`
+	Script      Script `json:"script"`
 }
 
 func (s *StacksService) Update(params UpdateStacksParams) (*UpdateResponse, error) {
+	if err := params.Script.Validate(); err != nil {
+		return nil, err
+	}
+
 	reqUrl := "stacks/" + params.StackId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var stacks UpdateResponse
-	_, err := s.client.Do(req, &stacks)
+	resp, err := s.client.Do(req, &stacks)
 	if err != nil {
 		return nil, err
 	}
 	if stacks.Status != "success" && stacks.Status != "" {
-		return nil, errors.New(stacks.Message)
+		return nil, newErrorResponse(resp, stacks.Message)
 	}
 
 	return &stacks, nil
@@ -118,15 +138,45 @@ func (s *StacksService) Update(params UpdateStacksParams) (*UpdateResponse, erro
 
 func (s *StacksService) Delete(stackId string) (*DeleteResponse, error) {
 	reqUrl := "stacks/" + stackId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
+
+type DeployStackParams struct {
+	StackId  string
+	Dcslug   string
+	Planid   string
+	Image    string
+	Hostname string
+	// Fields holds the values of the variables declared by the stack
+	Fields map[string]string
+}
+
+// Deploy launches a new cloud instance running the given stack
+func (s *StacksService) Deploy(params DeployStackParams) (*CreateCloudInstanceResponse, error) {
+	if params.StackId == "" {
+		return nil, errors.New("stack id is required")
+	}
+
+	return (*CloudInstancesService)(s).Create(CreateCloudInstanceParams{
+		Dcslug:      params.Dcslug,
+		Planid:      params.Planid,
+		Image:       params.Image,
+		Cloud:       []CloudHostname{{Hostname: params.Hostname}},
+		Stackid:     params.StackId,
+		StackFields: params.Fields,
+	})
+}
@@ -47,6 +47,14 @@ func TestStacksService_Create_invalidServer(t *testing.T) {
 	}
 }
 
+func TestStacksService_Create_invalidParams(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Stacks().Create(CreateStacksParams{Title: "stackname", Script: " \n"})
+	assert.EqualError(t, err, "script can't be empty")
+	assert.Nil(t, got)
+}
+
 func TestStacksService_Read_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
@@ -212,3 +220,43 @@ const dummyStacksServerRes = `{
 }`
 
 const dummyListStacksRes = `[` + dummyReadStacksRes + `]`
+
+func TestStacksService_Deploy_happyPath(t *testing.T) {
+	token := "token"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/cloud/deploy", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got CreateCloudInstanceParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, "2233", got.Stackid)
+		assert.Equal(t, map[string]string{"db_password": "secret"}, got.StackFields)
+		assert.Equal(t, []CloudHostname{{Hostname: "wordpress-1"}}, got.Cloud)
+
+		fmt.Fprint(w, `{"status":"success","cloudid":"1277662","ipv4":"103.209.111.111"}`)
+	})
+
+	got, err := client.Stacks().Deploy(DeployStackParams{
+		StackId:  "2233",
+		Dcslug:   "innoida",
+		Planid:   "10045",
+		Image:    "ubuntu-22.04-x86_64",
+		Hostname: "wordpress-1",
+		Fields:   map[string]string{"db_password": "secret"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1277662", got.ID)
+}
+
+func TestStacksService_Deploy_missingStack(t *testing.T) {
+	client, _ := NewClient("token")
+
+	got, err := client.Stacks().Deploy(DeployStackParams{})
+	assert.NotNil(t, err)
+	assert.Nil(t, got)
+}
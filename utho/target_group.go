@@ -1,7 +1,7 @@
 package utho
 
 import (
-	"errors"
+	"net/url"
 )
 
 type TargetGroupService service
@@ -60,15 +60,18 @@ type CreateTargetGroupResponse struct {
 
 func (s *TargetGroupService) Create(params CreateTargetGroupParams) (*CreateTargetGroupResponse, error) {
 	reqUrl := "targetgroup"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroup CreateTargetGroupResponse
-	_, err := s.client.Do(req, &targetgroup)
+	resp, err := s.client.Do(req, &targetgroup)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroup.Status != "success" && targetgroup.Status != "" {
-		return nil, errors.New(targetgroup.Message)
+		return nil, newErrorResponse(resp, targetgroup.Message)
 	}
 
 	return &targetgroup, nil
@@ -76,15 +79,18 @@ func (s *TargetGroupService) Create(params CreateTargetGroupParams) (*CreateTarg
 
 func (s *TargetGroupService) Read(targetGroupId string) (*TargetGroup, error) {
 	reqUrl := "targetgroup"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroup TargetGroups
-	_, err := s.client.Do(req, &targetgroup)
+	resp, err := s.client.Do(req, &targetgroup)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroup.Status != "success" && targetgroup.Status != "" {
-		return nil, errors.New(targetgroup.Message)
+		return nil, newErrorResponse(resp, targetgroup.Message)
 	}
 
 	var targetGroup TargetGroup
@@ -94,7 +100,7 @@ func (s *TargetGroupService) Read(targetGroupId string) (*TargetGroup, error) {
 		}
 	}
 	if len(targetGroup.ID) == 0 {
-		return nil, errors.New("target groupId not found")
+		return nil, newNotFoundError("target groupId not found")
 	}
 
 	return &targetGroup, nil
@@ -102,22 +108,25 @@ func (s *TargetGroupService) Read(targetGroupId string) (*TargetGroup, error) {
 
 func (s *TargetGroupService) List() ([]TargetGroup, error) {
 	reqUrl := "targetgroup"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroups TargetGroups
-	_, err := s.client.Do(req, &targetgroups)
+	resp, err := s.client.Do(req, &targetgroups)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroups.Status != "success" && targetgroups.Status != "" {
-		return nil, errors.New(targetgroups.Message)
+		return nil, newErrorResponse(resp, targetgroups.Message)
 	}
 
 	return targetgroups.Targetgroups, nil
 }
 
 type UpdateTargetGroupParams struct {
-	TargetGroupId       string
+	TargetGroupId       string `json:"-"`
 	Name                string `json:"name"`
 	Protocol            string `json:"protocol"`
 	Port                string `json:"port"`
@@ -131,38 +140,45 @@ type UpdateTargetGroupParams struct {
 
 func (s *TargetGroupService) Update(params UpdateTargetGroupParams) (*UpdateResponse, error) {
 	reqUrl := "targetgroup/" + params.TargetGroupId
-	req, _ := s.client.NewRequest("PUT", reqUrl, &params)
+	req, err := s.client.NewRequest("PUT", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroup UpdateResponse
-	_, err := s.client.Do(req, &targetgroup)
+	resp, err := s.client.Do(req, &targetgroup)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroup.Status != "success" && targetgroup.Status != "" {
-		return nil, errors.New(targetgroup.Message)
+		return nil, newErrorResponse(resp, targetgroup.Message)
 	}
 
 	return &targetgroup, nil
 }
 
 func (s *TargetGroupService) Delete(targetGroupId, targetGroupName string) (*DeleteResponse, error) {
-	reqUrl := "targetgroup/" + targetGroupId + "?name=" + targetGroupName
+	reqUrl := "targetgroup/" + targetGroupId + "?name=" + url.QueryEscape(targetGroupName)
 	// targetgroup/:id?name=target_group_name
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
 }
 
 type CreateTargetGroupTargetParams struct {
-	TargetGroupId   string
+	TargetGroupId   string `json:"-"`
 	BackendProtocol string `json:"backend_protocol"`
 	BackendPort     string `json:"backend_port"`
 	IP              string `json:"ip"`
@@ -171,15 +187,18 @@ type CreateTargetGroupTargetParams struct {
 
 func (s *TargetGroupService) CreateTarget(params CreateTargetGroupTargetParams) (*CreateResponse, error) {
 	reqUrl := "targetgroup/" + params.TargetGroupId + "/target"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroup CreateResponse
-	_, err := s.client.Do(req, &targetgroup)
+	resp, err := s.client.Do(req, &targetgroup)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroup.Status != "success" && targetgroup.Status != "" {
-		return nil, errors.New(targetgroup.Message)
+		return nil, newErrorResponse(resp, targetgroup.Message)
 	}
 
 	return &targetgroup, nil
@@ -187,15 +206,18 @@ func (s *TargetGroupService) CreateTarget(params CreateTargetGroupTargetParams)
 
 func (s *TargetGroupService) ReadTarget(targetGroupId, targetId string) (*Target, error) {
 	reqUrl := "targetgroup"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroup TargetGroups
-	_, err := s.client.Do(req, &targetgroup)
+	resp, err := s.client.Do(req, &targetgroup)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroup.Status != "success" && targetgroup.Status != "" {
-		return nil, errors.New(targetgroup.Message)
+		return nil, newErrorResponse(resp, targetgroup.Message)
 	}
 
 	var targetGroup TargetGroup
@@ -205,7 +227,7 @@ func (s *TargetGroupService) ReadTarget(targetGroupId, targetId string) (*Target
 		}
 	}
 	if len(targetGroup.ID) == 0 {
-		return nil, errors.New("target groupId not found")
+		return nil, newNotFoundError("target groupId not found")
 	}
 
 	var target Target
@@ -215,7 +237,7 @@ func (s *TargetGroupService) ReadTarget(targetGroupId, targetId string) (*Target
 		}
 	}
 	if len(target.ID) == 0 {
-		return nil, errors.New("target groupId not found")
+		return nil, newNotFoundError("targetId not found")
 	}
 
 	return &target, nil
@@ -223,15 +245,18 @@ func (s *TargetGroupService) ReadTarget(targetGroupId, targetId string) (*Target
 
 func (s *TargetGroupService) ListTargets(targetGroupId string) ([]Target, error) {
 	reqUrl := "targetgroup"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var targetgroups TargetGroups
-	_, err := s.client.Do(req, &targetgroups)
+	resp, err := s.client.Do(req, &targetgroups)
 	if err != nil {
 		return nil, err
 	}
 	if targetgroups.Status != "success" && targetgroups.Status != "" {
-		return nil, errors.New(targetgroups.Message)
+		return nil, newErrorResponse(resp, targetgroups.Message)
 	}
 
 	var targetGroup TargetGroup
@@ -241,7 +266,7 @@ func (s *TargetGroupService) ListTargets(targetGroupId string) ([]Target, error)
 		}
 	}
 	if len(targetGroup.ID) == 0 {
-		return nil, errors.New("target groupId not found")
+		return nil, newNotFoundError("target groupId not found")
 	}
 
 	return targetGroup.Targets, nil
@@ -249,14 +274,18 @@ func (s *TargetGroupService) ListTargets(targetGroupId string) ([]Target, error)
 
 func (s *TargetGroupService) DeleteTarget(targetGroupId, targetId string) (*DeleteResponse, error) {
 	reqUrl := "targetgroup/" + targetGroupId + "/target/" + targetId
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
@@ -86,6 +86,20 @@ func TestTargetGroupService_Read_invalidServer(t *testing.T) {
 	}
 }
 
+func TestTargetGroupService_Read_notFound(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/targetgroup", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"targetgroups":[],"status":"success"}`)
+	})
+
+	got, err := client.TargetGroup().Read("gone")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, got)
+}
+
 func TestTargetGroupService_List_happyPath(t *testing.T) {
 	client, mux, _, teardown := setup("token")
 	defer teardown()
@@ -215,6 +229,12 @@ func TestTargetGroupService_CreateTarget_happyPath(t *testing.T) {
 	mux.HandleFunc("/targetgroup/"+payload.TargetGroupId+"/target", func(w http.ResponseWriter, req *http.Request) {
 		testHttpMethod(t, req, http.MethodPost)
 		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.NotContains(t, got, "TargetGroupId")
+		assert.Equal(t, "11.11.11.11", got["ip"])
+
 		fmt.Fprint(w, dummyCreateResponseJson)
 	})
 
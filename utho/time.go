@@ -0,0 +1,29 @@
+package utho
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the layouts the API formats dates with, most common first
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"2006-01-02",
+}
+
+// parseTimestamp parses a date returned by the API.
+// Dates without a zone are read as UTC and an empty date gives the zero time.
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" || value == "0000-00-00 00:00:00" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised timestamp %q", value)
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"runtime/debug"
 	"strings"
 	"time"
 )
@@ -15,6 +16,21 @@ const BaseUrl = "https://api.utho.com/v2/"
 
 var defaultHTTPClient = &http.Client{Timeout: time.Second * 300}
 
+const modulePath = "github.com/uthoplatforms/utho-go"
+
+// defaultUserAgent identifies the SDK, along with its module version when the build records one
+var defaultUserAgent = func() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				return "utho-go/" + dep.Version
+			}
+		}
+	}
+
+	return "utho-go"
+}()
+
 type Client interface {
 	NewRequest(method, url string, body ...interface{}) (*http.Request, error)
 	Do(req *http.Request, v interface{}) (*http.Response, error)
@@ -36,6 +52,20 @@ type Client interface {
 	Vpc() *VpcService
 	AutoScaling() *AutoScalingService
 	Kubernetes() *KubernetesService
+	Ebs() *EBService
+}
+
+// RawDoer is implemented by the clients returned by NewClient. It is kept out of Client
+// so that existing implementations of Client, e.g. test doubles, don't have to add it.
+type RawDoer interface {
+	DoRaw(req *http.Request, v interface{}) ([]byte, *http.Response, error)
+}
+
+// ServerClock is implemented by the clients returned by NewClient and reads the Utho API clock,
+// e.g. to catch a drifting local clock before signing time-sensitive requests. It is kept out of Client like RawDoer.
+type ServerClock interface {
+	ServerTime() (time.Time, error)
+	ClockSkew() (time.Duration, error)
 }
 
 type service struct {
@@ -47,6 +77,15 @@ type client struct {
 	baseURL *url.URL
 	token   string
 
+	userAgent       string
+	requestRecorder RequestRecorder
+	maxRetries      int
+	retryBaseDelay  time.Duration
+	retryPredicate  RetryPredicate
+	rateLimiter     RateLimiter
+	debug           io.Writer
+	timeout         time.Duration
+
 	account        *AccountService
 	apiKey         *ApiKeyService
 	action         *ActionService
@@ -64,11 +103,17 @@ type client struct {
 	vpc            *VpcService
 	autoscaling    *AutoScalingService
 	kubernetes     *KubernetesService
+	ebs            *EBService
 }
 
 // NewClient creates a new Utho client.
 // Because the token supplied will be used for all authenticated requests,
-// the created client should not be used across different users
+// the created client should not be used across different users.
+//
+// The client is safe for concurrent use by multiple goroutines and should be reused,
+// so that connections are pooled. Its configuration, token and services are fixed
+// once NewClient returns; state that is ever initialised lazily must be guarded by a sync.Once.
+// Callbacks given as options, such as a RequestRecorder, may be called concurrently.
 func NewClient(token string, options ...UthoOption) (Client, error) {
 	if token == "" {
 		return nil, errors.New("you must provide an API token")
@@ -80,9 +125,10 @@ func NewClient(token string, options ...UthoOption) (Client, error) {
 	}
 
 	client := &client{
-		client:  defaultHTTPClient,
-		baseURL: defaultBaseURL,
-		token:   token,
+		client:    defaultHTTPClient,
+		baseURL:   defaultBaseURL,
+		token:     token,
+		userAgent: defaultUserAgent,
 	}
 
 	for _, option := range options {
@@ -90,6 +136,11 @@ func NewClient(token string, options ...UthoOption) (Client, error) {
 			return nil, err
 		}
 	}
+	if client.timeout > 0 {
+		httpClient := *client.client
+		httpClient.Timeout = client.timeout
+		client.client = &httpClient
+	}
 
 	commonService := &service{client: client}
 	client.account = (*AccountService)(commonService)
@@ -109,6 +160,7 @@ func NewClient(token string, options ...UthoOption) (Client, error) {
 	client.vpc = (*VpcService)(commonService)
 	client.autoscaling = (*AutoScalingService)(commonService)
 	client.kubernetes = (*KubernetesService)(commonService)
+	client.ebs = (*EBService)(commonService)
 
 	return client, nil
 }
@@ -136,7 +188,7 @@ func (c *client) NewRequest(method, url string, body ...interface{}) (*http.Requ
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	var buf *bytes.Buffer
 	if len(body) > 0 && body[0] != nil {
 		buf = &bytes.Buffer{}
 		enc := json.NewEncoder(buf)
@@ -147,46 +199,104 @@ func (c *client) NewRequest(method, url string, body ...interface{}) (*http.Requ
 		}
 	}
 
-	req, err := http.NewRequest(method, fullUrl.String(), buf)
+	if c.requestRecorder != nil {
+		var recorded []byte
+		if buf != nil {
+			recorded = bytes.Clone(buf.Bytes())
+		}
+		c.requestRecorder(method, fullUrl.String(), recorded)
+	}
+
+	var reqBody io.Reader
+	if buf != nil {
+		reqBody = buf
+	}
+
+	req, err := http.NewRequest(method, fullUrl.String(), reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept-Encoding", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
 
 	return req, nil
 }
 
 // Do will send the given request using the client `c` on which it is called.
-// If the response contains a body, it will be unmarshalled in `v`.
+// If the response contains a body, it will be unmarshalled in `v`,
+// or copied as-is when `v` is an io.Writer.
 func (c *client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	req.Header.Set("Authorization", "Bearer "+c.token)
 
-	resp, err := c.client.Do(req)
+	if c.debug != nil {
+		dumpRequest(c.debug, req)
+	}
+
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	defer func() {
+		// drain what the decoder left unread, e.g. a trailing newline, so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if c.debug != nil {
+		dumpResponse(c.debug, resp)
+	}
 
 	err = checkForErrors(resp)
 	if err != nil {
 		return resp, err
 	}
 
+	if w, ok := v.(io.Writer); ok && resp.Body != nil {
+		_, err = io.Copy(w, resp.Body)
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return resp, nil
+	}
+
 	if resp.Body != nil && v != nil {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
+		// successful bodies are decoded as they are read so large listings are never held in memory twice,
+		// error bodies are small and are buffered by checkForErrors instead
+		err = json.NewDecoder(resp.Body).Decode(v)
+		// deletes and power actions may answer without a body
+		if err != nil && err != io.EOF {
 			return resp, err
 		}
+	}
 
-		err = json.Unmarshal(body, &v)
-		if err != nil {
-			return resp, err
+	return resp, nil
+}
+
+// DoRaw works like Do but also returns the raw JSON body of a successful response,
+// e.g. to read fields the SDK structs don't have yet. The body is read once, then unmarshalled in `v`
+// unless `v` is nil.
+//
+//	req, _ := client.NewRequest("GET", "cloud/" + instanceId)
+//	var instances utho.CloudInstances
+//	raw, _, err := client.(utho.RawDoer).DoRaw(req, &instances)
+func (c *client) DoRaw(req *http.Request, v interface{}) ([]byte, *http.Response, error) {
+	var body bytes.Buffer
+	resp, err := c.Do(req, &body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	raw := body.Bytes()
+	if v != nil && len(bytes.TrimSpace(raw)) > 0 {
+		if err := json.Unmarshal(raw, v); err != nil {
+			return raw, resp, err
 		}
 	}
 
-	return resp, nil
+	return raw, resp, nil
 }
 
 func checkForErrors(resp *http.Response) error {
@@ -201,10 +311,52 @@ func checkForErrors(resp *http.Response) error {
 		// it's ok if we cannot unmarshal to Utho's error response
 		_ = json.Unmarshal(data, errorResponse)
 	}
+	errorResponse.StatusCode = resp.StatusCode
+	errorResponse.RequestID = requestID(resp)
+	if len(errorResponse.Errors) > 0 {
+		if errorResponse.Message == "" {
+			errorResponse.Message = errorResponse.Errors[0].Message
+		}
+		if errorResponse.Code == "" {
+			errorResponse.Code = errorResponse.Errors[0].Code
+		}
+	}
 
 	return errorResponse
 }
 
+// ServerTime returns the current time of the Utho API, read from the Date header of a lightweight request.
+func (c *client) ServerTime() (time.Time, error) {
+	req, err := c.NewRequest("GET", "account/info")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the Date header is present on error responses too, so only transport errors matter
+	resp, err := c.Do(req, nil)
+	if resp == nil {
+		return time.Time{}, err
+	}
+
+	date := resp.Header.Get("Date")
+	if date == "" {
+		return time.Time{}, errors.New("response has no Date header")
+	}
+
+	return http.ParseTime(date)
+}
+
+// ClockSkew returns how far the local clock is ahead of the Utho API clock.
+// A negative value means the local clock is behind. The Date header has a one second resolution.
+func (c *client) ClockSkew() (time.Duration, error) {
+	serverTime, err := c.ServerTime()
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Since(serverTime), nil
+}
+
 func (c *client) Account() *AccountService {
 	return c.account
 }
@@ -272,3 +424,7 @@ func (c *client) AutoScaling() *AutoScalingService {
 func (c *client) Kubernetes() *KubernetesService {
 	return c.kubernetes
 }
+
+func (c *client) Ebs() *EBService {
+	return c.ebs
+}
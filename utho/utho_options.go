@@ -1,8 +1,12 @@
 package utho
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
+	"io"
 	"net/http"
+	"time"
 )
 
 // UthoOption describes a functional parameter for the utho client constructor
@@ -36,3 +40,189 @@ func WithBaseURL(rawURL string) UthoOption {
 		return nil
 	}
 }
+
+// WithTimeout limits the time of each HTTP request, retries excluded, 300 seconds by default.
+// It applies to the client given with WithHTTPClient as well, whatever the order of the options,
+// without modifying that client.
+func WithTimeout(d time.Duration) UthoOption {
+	return func(c *client) error {
+		if d <= 0 {
+			return errors.New("timeout must be positive")
+		}
+
+		c.timeout = d
+		return nil
+	}
+}
+
+// WithTransport sends requests through rt, e.g. a RoundTripper recording traces or metrics
+// that wraps http.DefaultTransport.
+// It replaces the transport on a copy of the current http client, so the client's other settings,
+// including those given with WithHTTPClient, are kept when it is applied after WithHTTPClient.
+// WithConnectionPool only works on an *http.Transport and must not be applied after it.
+func WithTransport(rt http.RoundTripper) UthoOption {
+	return func(c *client) error {
+		if rt == nil {
+			return errors.New("transport can't be nil")
+		}
+
+		httpClient := *c.client
+		httpClient.Transport = rt
+		c.client = &httpClient
+		return nil
+	}
+}
+
+// WithConnectionPool tunes the idle connection pool of the client's transport.
+// It works on a copy of the current transport, so apply it after WithHTTPClient when both are used.
+func WithConnectionPool(maxIdle, maxIdlePerHost int, idleTimeout time.Duration) UthoOption {
+	return func(c *client) error {
+		if maxIdle < 0 || maxIdlePerHost < 0 || idleTimeout < 0 {
+			return errors.New("connection pool settings can't be negative")
+		}
+
+		transport, err := cloneTransport(c.client)
+		if err != nil {
+			return err
+		}
+		transport.MaxIdleConns = maxIdle
+		transport.MaxIdleConnsPerHost = maxIdlePerHost
+		transport.IdleConnTimeout = idleTimeout
+
+		httpClient := *c.client
+		httpClient.Transport = transport
+		c.client = &httpClient
+		return nil
+	}
+}
+
+// WithSkipTLSVerify disables the verification of the API server's TLS certificate,
+// e.g. for a staging gateway with a self-signed certificate given with WithBaseURL.
+//
+// WARNING: this is for development endpoints only. Without verification anyone on the network
+// path can impersonate the API and read the API token, never use it against production.
+//
+// It works on a copy of the current transport, so apply it after WithHTTPClient when both are used.
+func WithSkipTLSVerify() UthoOption {
+	return func(c *client) error {
+		transport, err := cloneTransport(c.client)
+		if err != nil {
+			return err
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true
+
+		httpClient := *c.client
+		httpClient.Transport = transport
+		c.client = &httpClient
+		return nil
+	}
+}
+
+// cloneTransport returns a copy of the transport used by httpClient, so that
+// options never modify a transport shared with other clients.
+func cloneTransport(httpClient *http.Client) (*http.Transport, error) {
+	switch transport := httpClient.Transport.(type) {
+	case nil:
+		return http.DefaultTransport.(*http.Transport).Clone(), nil
+	case *http.Transport:
+		return transport.Clone(), nil
+	default:
+		return nil, errors.New("http client transport must be an *http.Transport")
+	}
+}
+
+// RequestRecorder receives every request built by the client, with its JSON encoded body.
+// body is nil for requests without one.
+type RequestRecorder func(method, url string, body []byte)
+
+// WithRequestRecorder registers a recorder that is called before each request is sent, e.g. for audit logs
+func WithRequestRecorder(recorder RequestRecorder) UthoOption {
+	return func(c *client) error {
+		if recorder == nil {
+			return errors.New("request recorder can't be nil")
+		}
+
+		c.requestRecorder = recorder
+		return nil
+	}
+}
+
+// WithRetry retries transient failures up to maxRetries times, backing off exponentially from baseDelay.
+// GET and DELETE requests are retried on 429 and 5xx responses, honouring Retry-After, and on
+// transport errors. Other requests are only retried when the connection could not be established,
+// see DefaultRetryPredicate.
+// Use WithRetryPredicate to change which attempts are retried.
+func WithRetry(maxRetries int, baseDelay time.Duration) UthoOption {
+	return func(c *client) error {
+		if maxRetries < 0 {
+			return errors.New("max retries can't be negative")
+		}
+		if baseDelay <= 0 {
+			return errors.New("retry base delay must be positive")
+		}
+
+		c.maxRetries = maxRetries
+		c.retryBaseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithRetryPredicate replaces DefaultRetryPredicate to decide which failed attempts are retried,
+// e.g. to retry POST endpoints known to be safe. It only has an effect along with WithRetry,
+// which still bounds the number of attempts and the backoff.
+func WithRetryPredicate(predicate RetryPredicate) UthoOption {
+	return func(c *client) error {
+		if predicate == nil {
+			return errors.New("retry predicate can't be nil")
+		}
+
+		c.retryPredicate = predicate
+		return nil
+	}
+}
+
+// RateLimiter blocks until a request may be sent or ctx is done.
+// *rate.Limiter from golang.org/x/time/rate satisfies it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// WithRateLimiter makes every outgoing request, retries included, wait for the limiter first
+func WithRateLimiter(limiter RateLimiter) UthoOption {
+	return func(c *client) error {
+		if limiter == nil {
+			return errors.New("rate limiter can't be nil")
+		}
+
+		c.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithUserAgent prepends ua, e.g. the name of the calling service, to the SDK's User-Agent header
+func WithUserAgent(ua string) UthoOption {
+	return func(c *client) error {
+		if ua == "" {
+			return errors.New("user agent can't be empty")
+		}
+
+		c.userAgent = ua + " " + defaultUserAgent
+		return nil
+	}
+}
+
+// WithDebug dumps every request and response, bodies included, to w.
+// The Authorization header is redacted.
+func WithDebug(w io.Writer) UthoOption {
+	return func(c *client) error {
+		if w == nil {
+			return errors.New("debug writer can't be nil")
+		}
+
+		c.debug = w
+		return nil
+	}
+}
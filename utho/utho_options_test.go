@@ -0,0 +1,328 @@
+package utho
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithConnectionPool_defaultClient(t *testing.T) {
+	c, err := NewClient("token", WithConnectionPool(50, 10, time.Minute))
+	assert.Nil(t, err)
+
+	transport := c.(*client).client.Transport.(*http.Transport)
+	assert.Equal(t, 50, transport.MaxIdleConns)
+	assert.Equal(t, 10, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+
+	// the shared default client and transport must be left untouched
+	assert.Nil(t, defaultHTTPClient.Transport)
+	assert.NotEqual(t, 50, http.DefaultTransport.(*http.Transport).MaxIdleConns)
+}
+
+func TestWithConnectionPool_customClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second, Transport: &http.Transport{MaxIdleConns: 1}}
+
+	c, err := NewClient("token", WithHTTPClient(httpClient), WithConnectionPool(50, 10, time.Minute))
+	assert.Nil(t, err)
+
+	got := c.(*client).client
+	assert.Equal(t, time.Second, got.Timeout)
+	assert.Equal(t, 50, got.Transport.(*http.Transport).MaxIdleConns)
+	assert.Equal(t, 1, httpClient.Transport.(*http.Transport).MaxIdleConns)
+}
+
+func TestWithSkipTLSVerify(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"user":{"id":"1"},"status":"success"}`)
+	}))
+	// the rejected handshake is expected, keep it out of the test output
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	secure, _ := NewClient("token", WithBaseURL(server.URL+"/v2/"))
+	_, err := secure.Account().Read()
+	assert.NotNil(t, err, "expected the self-signed certificate to be rejected by default")
+
+	insecure, err := NewClient("token", WithBaseURL(server.URL+"/v2/"), WithSkipTLSVerify())
+	assert.Nil(t, err)
+	_, err = insecure.Account().Read()
+	assert.Nil(t, err)
+
+	// the shared default client and transport must be left untouched
+	assert.Nil(t, defaultHTTPClient.Transport)
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil {
+		assert.False(t, config.InsecureSkipVerify)
+	}
+}
+
+func TestWithSkipTLSVerify_customTransport(t *testing.T) {
+	tlsConfig := &tls.Config{ServerName: "staging.example.com"}
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+
+	c, err := NewClient("token", WithHTTPClient(httpClient), WithSkipTLSVerify())
+	assert.Nil(t, err)
+
+	got := c.(*client).client.Transport.(*http.Transport).TLSClientConfig
+	assert.True(t, got.InsecureSkipVerify)
+	assert.Equal(t, "staging.example.com", got.ServerName)
+	assert.False(t, tlsConfig.InsecureSkipVerify)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithConnectionPool_invalid(t *testing.T) {
+	_, err := NewClient("token", WithConnectionPool(-1, 10, time.Minute))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+
+	httpClient := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+	_, err = NewClient("token", WithHTTPClient(httpClient), WithConnectionPool(50, 10, time.Minute))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	_, mux, serverURL, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/account/info", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"user":{"id":"1"},"status":"success"}`)
+	})
+
+	// a tracing middleware, such as otelhttp.NewTransport, wraps the default transport the same way
+	var spans []string
+	tracing := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		if err == nil {
+			spans = append(spans, req.Method+" "+req.URL.Path+" "+resp.Status)
+		}
+		return resp, err
+	})
+
+	httpClient := &http.Client{Timeout: time.Second}
+	c, err := NewClient("token", WithBaseURL(serverURL.String()), WithHTTPClient(httpClient), WithTransport(tracing))
+	assert.Nil(t, err)
+
+	_, err = c.Account().Read()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /v2/account/info 200 OK"}, spans)
+	assert.Equal(t, time.Second, c.(*client).client.Timeout)
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestWithTransport_nil(t *testing.T) {
+	_, err := NewClient("token", WithTransport(nil))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	c, err := NewClient("token", WithTimeout(5*time.Second))
+	assert.Nil(t, err)
+	assert.Equal(t, 5*time.Second, c.(*client).client.Timeout)
+	assert.Equal(t, 300*time.Second, defaultHTTPClient.Timeout)
+
+	httpClient := &http.Client{Timeout: time.Minute}
+	for _, options := range [][]UthoOption{
+		{WithHTTPClient(httpClient), WithTimeout(5 * time.Second)},
+		{WithTimeout(5 * time.Second), WithHTTPClient(httpClient)},
+	} {
+		c, err := NewClient("token", options...)
+		assert.Nil(t, err)
+		assert.Equal(t, 5*time.Second, c.(*client).client.Timeout)
+	}
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+}
+
+func TestWithTimeout_invalid(t *testing.T) {
+	_, err := NewClient("token", WithTimeout(0))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithRequestRecorder(t *testing.T) {
+	type recorded struct {
+		method, url string
+		body        []byte
+	}
+	var got []recorded
+
+	c, err := NewClient("token", WithBaseURL("https://example.com/v2/"), WithRequestRecorder(func(method, url string, body []byte) {
+		got = append(got, recorded{method, url, body})
+	}))
+	assert.Nil(t, err)
+
+	_, _ = c.NewRequest("POST", "firewall/create", CreateFirewallParams{Name: "web"})
+	_, _ = c.NewRequest("GET", "firewall")
+
+	want := []recorded{
+		{"POST", "https://example.com/v2/firewall/create", []byte("{\"name\":\"web\"}\n")},
+		{"GET", "https://example.com/v2/firewall", nil},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestWithRequestRecorder_nil(t *testing.T) {
+	_, err := NewClient("token", WithRequestRecorder(nil))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+
+	c, err := NewClient("token", WithHTTPClient(httpClient))
+	assert.Nil(t, err)
+	assert.Same(t, httpClient, c.(*client).client)
+}
+
+func TestWithHTTPClient_nil(t *testing.T) {
+	_, err := NewClient("token", WithHTTPClient(nil))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	c, err := NewClient("token", WithBaseURL("https://staging.example.com/v2"))
+	assert.Nil(t, err)
+	assert.Equal(t, "https://staging.example.com/v2/", c.(*client).baseURL.String())
+}
+
+func TestWithBaseURL_invalid(t *testing.T) {
+	for _, rawURL := range []string{"", "://missing-scheme", "https://exa mple.com"} {
+		_, err := NewClient("token", WithBaseURL(rawURL))
+		if err == nil {
+			t.Errorf("Expected error to be returned for %q", rawURL)
+		}
+	}
+}
+
+type countingLimiter struct {
+	waits int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	if l.err != nil {
+		return l.err
+	}
+	return ctx.Err()
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	limiter := &countingLimiter{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", WithBaseURL(server.URL), WithRateLimiter(limiter))
+	assert.Nil(t, err)
+
+	_, err = c.ApiKey().Delete("1")
+	assert.Nil(t, err)
+	_, err = c.ApiKey().Delete("2")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, limiter.waits)
+}
+
+func TestWithRateLimiter_waitError(t *testing.T) {
+	limiter := &countingLimiter{err: context.Canceled}
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	c, _ := NewClient("token", WithBaseURL(server.URL), WithRateLimiter(limiter))
+
+	_, err := c.ApiKey().Delete("1")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, calls)
+}
+
+func TestWithRateLimiter_nil(t *testing.T) {
+	_, err := NewClient("token", WithRateLimiter(nil))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	c, err := NewClient("token", WithUserAgent("provisioner/2.1"))
+	assert.Nil(t, err)
+
+	req, _ := c.NewRequest("GET", "cloud")
+	assert.Equal(t, "provisioner/2.1 "+defaultUserAgent, req.Header.Get("User-Agent"))
+}
+
+func TestWithUserAgent_default(t *testing.T) {
+	c, _ := NewClient("token")
+
+	req, _ := c.NewRequest("GET", "cloud")
+	assert.Equal(t, defaultUserAgent, req.Header.Get("User-Agent"))
+	assert.Contains(t, req.Header.Get("User-Agent"), "utho-go")
+}
+
+func TestWithUserAgent_empty(t *testing.T) {
+	_, err := NewClient("token", WithUserAgent(""))
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestWithDebug(t *testing.T) {
+	token := "s3cr3t-token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"success","id":"42"}`)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	c, err := NewClient(token, WithBaseURL(server.URL), WithDebug(&out))
+	assert.Nil(t, err)
+
+	req, _ := c.NewRequest("POST", "vpc/create", map[string]string{"name": "private"})
+	var got CreateResponse
+	_, err = c.Do(req, &got)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "42", got.ID)
+
+	dump := out.String()
+	assert.Contains(t, dump, "> POST "+server.URL+"/vpc/create")
+	assert.Contains(t, dump, `{"name":"private"}`)
+	assert.Contains(t, dump, "< 201 Created")
+	assert.Contains(t, dump, `{"status":"success","id":"42"}`)
+	assert.Contains(t, dump, "> Authorization: REDACTED")
+	assert.NotContains(t, dump, token)
+}
+
+func TestWithDebug_nil(t *testing.T) {
+	_, err := NewClient("token", WithDebug(nil))
+	assert.NotNil(t, err)
+}
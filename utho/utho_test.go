@@ -0,0 +1,225 @@
+package utho
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ServerTime_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	serverTime := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	mux.HandleFunc("/account/info", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, "GET")
+		testHeader(t, req, "Authorization", "Bearer token")
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+	})
+
+	got, err := client.(ServerClock).ServerTime()
+
+	assert.Nil(t, err)
+	assert.True(t, serverTime.Equal(got))
+}
+
+func TestClient_ServerTime_errorResponse(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	serverTime := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	mux.HandleFunc("/account/info", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	got, err := client.(ServerClock).ServerTime()
+
+	assert.Nil(t, err)
+	assert.True(t, serverTime.Equal(got))
+}
+
+func TestClient_ClockSkew_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/account/info", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	})
+
+	got, err := client.(ServerClock).ClockSkew()
+
+	assert.Nil(t, err)
+	assert.InDelta(t, time.Hour, got, float64(2*time.Second))
+}
+
+func TestClient_ServerTime_invalidServer(t *testing.T) {
+	client, _ := NewClient("token", WithBaseURL("http://127.0.0.1:0/v2/"))
+
+	_, err := client.(ServerClock).ServerTime()
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestClient_Do_emptyBody(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId/destroy", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/cloud/someId/poweron", func(w http.ResponseWriter, req *http.Request) {
+		// 200 without any body
+	})
+
+	delResponse, err := client.CloudInstances().Delete("someId", DeleteCloudInstanceParams{Confirm: "I am aware this action will delete data and server permanently"})
+	assert.Nil(t, err)
+	assert.NotNil(t, delResponse)
+
+	basicResponse, err := client.CloudInstances().PowerOn("someId")
+	assert.Nil(t, err)
+	assert.NotNil(t, basicResponse)
+}
+
+func TestClient_Do_streamedBody(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"cloud":[`)
+		for i := 0; i < 1000; i++ {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"cloudid":"%d"}`, i)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, `],"status":"success"}`)
+	})
+	mux.HandleFunc("/cloud/someId/poweroff", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, " \n")
+	})
+	mux.HandleFunc("/cloud/someId/poweron", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":`)
+	})
+
+	instances, err := client.CloudInstances().List()
+	assert.Nil(t, err)
+	assert.Len(t, instances, 1000)
+	assert.Equal(t, "999", instances[999].ID)
+
+	_, err = client.CloudInstances().PowerOff("someId")
+	assert.Nil(t, err)
+
+	_, err = client.CloudInstances().PowerOn("someId")
+	assert.NotNil(t, err)
+}
+
+func TestClient_Do_reusesConnection(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId/poweron", func(w http.ResponseWriter, req *http.Request) {
+		// the decoder stops after the object, leaving the padding unread
+		fmt.Fprint(w, `{"status":"success"}`+strings.Repeat(" ", 512<<10))
+	})
+
+	var reused []bool
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			reused = append(reused, info.Reused)
+		},
+	}
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("POST", "cloud/someId/poweron")
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+		_, err := client.Do(req, &BasicResponse{})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, []bool{false, true}, reused)
+}
+
+func TestClient_DoRaw(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	body := `{"cloud":[{"cloudid":"someId","new_field":"new value"}],"status":"success"}`
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, body)
+	})
+	mux.HandleFunc("/cloud/otherId", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":"error","message":"Cloud server not found"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "cloud/someId")
+	var instances CloudInstances
+	raw, resp, err := client.(RawDoer).DoRaw(req, &instances)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, body, string(raw))
+	assert.Equal(t, "someId", instances.CloudInstance[0].ID)
+
+	var extra struct {
+		Cloud []struct {
+			NewField string `json:"new_field"`
+		} `json:"cloud"`
+	}
+	assert.Nil(t, json.Unmarshal(raw, &extra))
+	assert.Equal(t, "new value", extra.Cloud[0].NewField)
+
+	req, _ = client.NewRequest("GET", "cloud/otherId")
+	raw, _, err = client.(RawDoer).DoRaw(req, nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, raw)
+}
+
+func TestClient_concurrentUse(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/cloud/someId", func(w http.ResponseWriter, req *http.Request) {
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"cloud":[{"cloudid":"someId"}],"status":"success"}`)
+	})
+	mux.HandleFunc("/vpc", func(w http.ResponseWriter, req *http.Request) {
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"vpc":[{"id":"someId"}],"status":"success"}`)
+	})
+	mux.HandleFunc("/firewall", func(w http.ResponseWriter, req *http.Request) {
+		testHeader(t, req, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"firewalls":[{"id":"someId"}],"status":"success"}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, err := client.CloudInstances().Read("someId")
+			assert.Nil(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.Vpc().List()
+			assert.Nil(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.Firewall().List()
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+}
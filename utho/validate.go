@@ -0,0 +1,27 @@
+package utho
+
+import (
+	"fmt"
+	"strings"
+)
+
+// requiredField pairs the JSON name of a parameter with its value
+type requiredField struct {
+	name  string
+	value string
+}
+
+// checkRequired returns an error naming every field left empty
+func checkRequired(fields ...requiredField) error {
+	var missing []string
+	for _, f := range fields {
+		if strings.TrimSpace(f.value) == "" {
+			missing = append(missing, f.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
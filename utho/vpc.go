@@ -1,7 +1,8 @@
 package utho
 
 import (
-	"errors"
+	"fmt"
+	"net"
 )
 
 type VpcService service
@@ -47,15 +48,18 @@ type CreateVpcParams struct {
 
 func (s *VpcService) Create(params CreateVpcParams) (*CreateResponse, error) {
 	reqUrl := "vpc/create"
-	req, _ := s.client.NewRequest("POST", reqUrl, &params)
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
 
 	var vpc CreateResponse
-	_, err := s.client.Do(req, &vpc)
+	resp, err := s.client.Do(req, &vpc)
 	if err != nil {
 		return nil, err
 	}
 	if vpc.Status != "success" && vpc.Status != "" {
-		return nil, errors.New(vpc.Message)
+		return nil, newErrorResponse(resp, vpc.Message)
 	}
 
 	return &vpc, nil
@@ -63,15 +67,18 @@ func (s *VpcService) Create(params CreateVpcParams) (*CreateResponse, error) {
 
 func (s *VpcService) Read(vpcId string) (*Vpc, error) {
 	reqUrl := "vpc"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var vpcs Vpcs
-	_, err := s.client.Do(req, &vpcs)
+	resp, err := s.client.Do(req, &vpcs)
 	if err != nil {
 		return nil, err
 	}
 	if vpcs.Status != "success" && vpcs.Status != "" {
-		return nil, errors.New(vpcs.Message)
+		return nil, newErrorResponse(resp, vpcs.Message)
 	}
 
 	var vpc Vpc
@@ -81,7 +88,7 @@ func (s *VpcService) Read(vpcId string) (*Vpc, error) {
 		}
 	}
 	if len(vpc.ID) == 0 {
-		return nil, errors.New("NotFound")
+		return nil, newNotFoundError("vpc %s not found", vpcId)
 	}
 
 	return &vpc, nil
@@ -89,15 +96,18 @@ func (s *VpcService) Read(vpcId string) (*Vpc, error) {
 
 func (s *VpcService) List() ([]Vpc, error) {
 	reqUrl := "vpc"
-	req, _ := s.client.NewRequest("GET", reqUrl)
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var vpc Vpcs
-	_, err := s.client.Do(req, &vpc)
+	resp, err := s.client.Do(req, &vpc)
 	if err != nil {
 		return nil, err
 	}
 	if vpc.Status != "success" && vpc.Status != "" {
-		return nil, errors.New(vpc.Message)
+		return nil, newErrorResponse(resp, vpc.Message)
 	}
 
 	return vpc.Vpc, nil
@@ -105,14 +115,156 @@ func (s *VpcService) List() ([]Vpc, error) {
 
 func (s *VpcService) Delete(vpcId string) (*DeleteResponse, error) {
 	reqUrl := "vpc/" + vpcId + "/destroy"
-	req, _ := s.client.NewRequest("DELETE", reqUrl)
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	var delResponse DeleteResponse
-	if _, err := s.client.Do(req, &delResponse); err != nil {
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
+		return nil, err
+	}
+	if delResponse.Status != "success" && delResponse.Status != "" {
+		return nil, newErrorResponse(resp, delResponse.Message)
+	}
+
+	return &delResponse, nil
+}
+
+type AttachVpcInstanceParams struct {
+	Cloudid string `json:"cloudid"`
+}
+
+// AttachInstance joins a cloud instance to a VPC.
+// The instance must live in the same datacenter as the VPC, otherwise the API rejects the request.
+func (s *VpcService) AttachInstance(vpcId, instanceId string) (*BasicResponse, error) {
+	reqUrl := "vpc/" + vpcId + "/server/add"
+	req, err := s.client.NewRequest("POST", reqUrl, AttachVpcInstanceParams{Cloudid: instanceId})
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+func (s *VpcService) DetachInstance(vpcId, instanceId string) (*BasicResponse, error) {
+	reqUrl := "vpc/" + vpcId + "/server/" + instanceId + "/delete"
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var basicResponse BasicResponse
+	resp, err := s.client.Do(req, &basicResponse)
+	if err != nil {
+		return nil, err
+	}
+	if basicResponse.Status != "success" && basicResponse.Status != "" {
+		return nil, newErrorResponse(resp, basicResponse.Message)
+	}
+
+	return &basicResponse, nil
+}
+
+func (s *VpcService) ListAttachedResources(vpcId string) ([]VpcResources, error) {
+	vpc, err := s.Read(vpcId)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpc.Resources, nil
+}
+
+type VpcRoutes struct {
+	Routes  []VpcRoute `json:"routes"`
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+}
+type VpcRoute struct {
+	ID          string `json:"id"`
+	Destination string `json:"destination"`
+	Nexthop     string `json:"nexthop"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type AddVpcRouteParams struct {
+	// Destination is the network the route applies to, in CIDR notation, e.g. "10.20.0.0/16"
+	Destination string `json:"destination"`
+	// Nexthop is the IP address, inside the VPC, traffic for Destination is forwarded to
+	Nexthop string `json:"nexthop"`
+}
+
+// AddRoute adds a static route to the route table of a VPC
+func (s *VpcService) AddRoute(vpcId string, params AddVpcRouteParams) (*CreateResponse, error) {
+	if _, _, err := net.ParseCIDR(params.Destination); err != nil {
+		return nil, fmt.Errorf("invalid route destination: %w", err)
+	}
+	if net.ParseIP(params.Nexthop) == nil {
+		return nil, fmt.Errorf("invalid route next hop %q", params.Nexthop)
+	}
+
+	reqUrl := "vpc/" + vpcId + "/route"
+	req, err := s.client.NewRequest("POST", reqUrl, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var route CreateResponse
+	resp, err := s.client.Do(req, &route)
+	if err != nil {
+		return nil, err
+	}
+	if route.Status != "success" && route.Status != "" {
+		return nil, newErrorResponse(resp, route.Message)
+	}
+
+	return &route, nil
+}
+
+// ListRoutes returns the static routes of a VPC
+func (s *VpcService) ListRoutes(vpcId string) ([]VpcRoute, error) {
+	reqUrl := "vpc/" + vpcId + "/route"
+	req, err := s.client.NewRequest("GET", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes VpcRoutes
+	resp, err := s.client.Do(req, &routes)
+	if err != nil {
+		return nil, err
+	}
+	if routes.Status != "success" && routes.Status != "" {
+		return nil, newErrorResponse(resp, routes.Message)
+	}
+
+	return routes.Routes, nil
+}
+
+func (s *VpcService) DeleteRoute(vpcId, routeId string) (*DeleteResponse, error) {
+	reqUrl := "vpc/" + vpcId + "/route/" + routeId
+	req, err := s.client.NewRequest("DELETE", reqUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var delResponse DeleteResponse
+	resp, err := s.client.Do(req, &delResponse)
+	if err != nil {
 		return nil, err
 	}
 	if delResponse.Status != "success" && delResponse.Status != "" {
-		return nil, errors.New(delResponse.Message)
+		return nil, newErrorResponse(resp, delResponse.Message)
 	}
 
 	return &delResponse, nil
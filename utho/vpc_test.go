@@ -153,6 +153,112 @@ func TestVpcService_Delete_invalidServer(t *testing.T) {
 	}
 }
 
+func TestVpcService_AddRoute_happyPath(t *testing.T) {
+	token := "token"
+	vpcId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/route", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		var params AddVpcRouteParams
+		_ = json.NewDecoder(req.Body).Decode(&params)
+		assert.Equal(t, AddVpcRouteParams{Destination: "10.20.0.0/16", Nexthop: "10.0.0.5"}, params)
+		fmt.Fprint(w, `{"id":"33333","status":"success","message":"Route added"}`)
+	})
+
+	got, err := client.Vpc().AddRoute(vpcId, AddVpcRouteParams{Destination: "10.20.0.0/16", Nexthop: "10.0.0.5"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, CreateResponse{ID: "33333", Status: "success", Message: "Route added"}, *got)
+}
+
+func TestVpcService_AddRoute_invalidParams(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Vpc().AddRoute("11111", AddVpcRouteParams{Destination: "10.20.0.0", Nexthop: "10.0.0.5"})
+	assert.ErrorContains(t, err, "invalid route destination")
+
+	_, err = client.Vpc().AddRoute("11111", AddVpcRouteParams{Destination: "10.20.0.0/16", Nexthop: "gateway"})
+	assert.ErrorContains(t, err, "invalid route next hop")
+}
+
+func TestVpcService_AddRoute_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Vpc().AddRoute("11111", AddVpcRouteParams{Destination: "10.20.0.0/16", Nexthop: "10.0.0.5"})
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestVpcService_ListRoutes_happyPath(t *testing.T) {
+	token := "token"
+	vpcId := "11111"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/route", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodGet)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, `{"status":"success","routes":[{"id":"33333","destination":"10.20.0.0/16","nexthop":"10.0.0.5","created_at":"2024-05-01 10:30:00"}]}`)
+	})
+
+	got, err := client.Vpc().ListRoutes(vpcId)
+
+	want := []VpcRoute{{ID: "33333", Destination: "10.20.0.0/16", Nexthop: "10.0.0.5", CreatedAt: "2024-05-01 10:30:00"}}
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestVpcService_ListRoutes_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	routes, err := client.Vpc().ListRoutes("11111")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if routes != nil {
+		t.Errorf("Was not expecting any routes to be returned, instead got %v", routes)
+	}
+}
+
+func TestVpcService_DeleteRoute_happyPath(t *testing.T) {
+	token := "token"
+	vpcId := "11111"
+	routeId := "33333"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/route/"+routeId, func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyDeleteResponseJson)
+	})
+
+	got, err := client.Vpc().DeleteRoute(vpcId, routeId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, DeleteResponse{Status: "success", Message: "success"}, *got)
+}
+
+func TestVpcService_DeleteRoute_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	delResponse, err := client.Vpc().DeleteRoute("11111", "33333")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+	if delResponse != nil {
+		t.Errorf("Was not expecting any reponse to be returned, instead got %v", delResponse)
+	}
+}
+
 const dummyReadVpcRes = `{
 	"id": "qwsdrf1-1bfa-46ef-8b94-f69f3qwszcf",
 	"total": 254,
@@ -173,3 +279,90 @@ const dummyVpcServerRes = `{
 }`
 
 const dummyListVpcRes = `[` + dummyReadVpcRes + `]`
+
+func TestVpcService_AttachInstance_happyPath(t *testing.T) {
+	token := "token"
+	vpcId := "11111"
+	instanceId := "22222"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/server/add", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodPost)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+
+		var got AttachVpcInstanceParams
+		_ = json.NewDecoder(req.Body).Decode(&got)
+		assert.Equal(t, instanceId, got.Cloudid)
+
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	got, err := client.Vpc().AttachInstance(vpcId, instanceId)
+
+	var want BasicResponse
+	_ = json.Unmarshal([]byte(dummyCreateBasicResponseJson), &want)
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, *got)
+}
+
+func TestVpcService_AttachInstance_otherDatacenter(t *testing.T) {
+	vpcId := "11111"
+
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/server/add", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status":"error","message":"Cloud server and VPC must be in the same location"}`)
+	})
+
+	got, err := client.Vpc().AttachInstance(vpcId, "22222")
+	assert.Nil(t, got)
+	assert.ErrorContains(t, err, "same location")
+}
+
+func TestVpcService_DetachInstance_happyPath(t *testing.T) {
+	token := "token"
+	vpcId := "11111"
+	instanceId := "22222"
+
+	client, mux, _, teardown := setup(token)
+	defer teardown()
+
+	mux.HandleFunc("/vpc/"+vpcId+"/server/"+instanceId+"/delete", func(w http.ResponseWriter, req *http.Request) {
+		testHttpMethod(t, req, http.MethodDelete)
+		testHeader(t, req, "Authorization", "Bearer "+token)
+		fmt.Fprint(w, dummyCreateBasicResponseJson)
+	})
+
+	_, err := client.Vpc().DetachInstance(vpcId, instanceId)
+	assert.Nil(t, err)
+}
+
+func TestVpcService_DetachInstance_invalidServer(t *testing.T) {
+	client, _ := NewClient("token")
+
+	_, err := client.Vpc().DetachInstance("11111", "22222")
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+}
+
+func TestVpcService_ListAttachedResources_happyPath(t *testing.T) {
+	client, mux, _, teardown := setup("token")
+	defer teardown()
+
+	mux.HandleFunc("/vpc", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","vpc":[{"id":"11111","resources":[{"type":"cloud","id":"22222","name":"web","ip":"10.0.0.2"}]}]}`)
+	})
+
+	got, err := client.Vpc().ListAttachedResources("11111")
+
+	want := []VpcResources{{Type: "cloud", ID: "22222", Name: "web", IP: "10.0.0.2"}}
+
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}